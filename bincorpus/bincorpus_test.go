@@ -0,0 +1,157 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bincorpus
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+const testFragment = `1	Die	die	ART	ART	nsf	2	DET
+2	Großaufnahme	Großaufnahme	N	NN	nsf	0	ROOT
+
+1	Gilles	Gilles	N	NE	nsm	0	ROOT	_	_	0.97
+2	Deleuze	Deleuze	N	NE	case:nominative|number:singular	1	APP	1	APP	0.5
+
+1	Die	_	_	_	_	_	_`
+
+func readAll(t *testing.T, reader conllx.SentenceReader) []conllx.Sentence {
+	var sentences []conllx.Sentence
+	for {
+		sentence, err := reader.ReadSentence()
+		if err == io.EOF {
+			return sentences
+		}
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		sentences = append(sentences, sentence.Clone())
+	}
+}
+
+func testSentences(t *testing.T) []conllx.Sentence {
+	return readAll(t, conllx.NewReader(bufio.NewReader(strings.NewReader(testFragment))))
+}
+
+func encode(t *testing.T, sentences []conllx.Sentence) []byte {
+	var buf bytes.Buffer
+	n, err := Convert(conllx.NewSliceReader(sentences), &buf)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if n != len(sentences) {
+		t.Fatalf("Expected %d sentences, converted %d", len(sentences), n)
+	}
+
+	return buf.Bytes()
+}
+
+func TestRoundTrip(t *testing.T) {
+	sentences := testSentences(t)
+
+	corpus, err := NewCorpus(encode(t, sentences))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if corpus.Len() != len(sentences) {
+		t.Fatalf("Expected %d sentences, got %d", len(sentences), corpus.Len())
+	}
+
+	if decoded := readAll(t, corpus.Reader()); !reflect.DeepEqual(decoded, sentences) {
+		t.Fatalf("Expected:\n%v\ngot:\n%v", sentences, decoded)
+	}
+
+	for _, n := range []int{2, 0, 1} {
+		sentence, err := corpus.Sentence(n)
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if !reflect.DeepEqual(sentence, sentences[n]) {
+			t.Fatalf("Expected sentence %d:\n%v\ngot:\n%v", n, sentences[n], sentence)
+		}
+	}
+
+	if _, err := corpus.Sentence(3); err == nil {
+		t.Fatal("Sentence out of bounds should not be returned")
+	}
+}
+
+func TestOpen(t *testing.T) {
+	sentences := testSentences(t)
+	path := filepath.Join(t.TempDir(), "corpus.bin")
+	if err := os.WriteFile(path, encode(t, sentences), 0644); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	corpus, err := Open(path)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	sentence, err := corpus.Sentence(1)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if err := corpus.Close(); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	// Sentences must not refer to the mapped memory.
+	if !reflect.DeepEqual(sentence, sentences[1]) {
+		t.Fatalf("Expected:\n%v\ngot:\n%v", sentences[1], sentence)
+	}
+
+	if _, err := corpus.Sentence(0); err == nil {
+		t.Fatal("Sentences should not be returned after closing")
+	}
+}
+
+func TestEmptyCorpus(t *testing.T) {
+	corpus, err := NewCorpus(encode(t, nil))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if _, err := corpus.Reader().ReadSentence(); err != io.EOF {
+		t.Fatal("Expected io.EOF, got:", err)
+	}
+}
+
+func TestCorrupt(t *testing.T) {
+	data := encode(t, testSentences(t))
+
+	for _, corrupt := range [][]byte{
+		nil,
+		data[:len(data)-1],
+		append([]byte("XXXXXXXX"), data[8:]...),
+		data[headerSize:],
+	} {
+		if _, err := NewCorpus(corrupt); err == nil {
+			t.Errorf("Corrupt data should not be opened: %q", corrupt)
+		}
+	}
+
+	writer := NewWriter(io.Discard)
+	if err := writer.Close(); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if err := writer.WriteSentence(testSentences(t)[0]); err == nil {
+		t.Fatal("Sentences should not be written after closing")
+	}
+}
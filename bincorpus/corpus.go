@@ -0,0 +1,274 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bincorpus
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+var errCorrupt = errors.New("Corrupt binary corpus")
+
+// A Corpus provides access to the sentences of a binary corpus.
+type Corpus struct {
+	data        []byte
+	tables      [nTables][]string
+	index       []byte
+	nSentences  int
+	sentenceEnd uint64
+	closer      func() error
+}
+
+// Open opens a binary corpus file. On Unix systems, the file is
+// memory-mapped, on other systems it is read into memory. The corpus
+// must be closed with Close.
+func Open(path string) (*Corpus, error) {
+	data, closer, err := mapFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	corpus, err := NewCorpus(data)
+	if err != nil {
+		closer()
+		return nil, err
+	}
+
+	corpus.closer = closer
+
+	return corpus, nil
+}
+
+// NewCorpus creates a corpus from the bytes of a binary corpus. The
+// data must not be modified while the corpus is used.
+func NewCorpus(data []byte) (*Corpus, error) {
+	if len(data) < headerSize+trailerSize || string(data[:len(magic)]) != magic {
+		return nil, errors.New("Data is not a binary corpus")
+	}
+
+	if v := binary.LittleEndian.Uint32(data[len(magic):]); v != version {
+		return nil, fmt.Errorf("Unsupported binary corpus version: %d", v)
+	}
+
+	trailer := data[len(data)-trailerSize:]
+	if string(trailer[24:]) != magic {
+		return nil, errCorrupt
+	}
+
+	tablesOffset := binary.LittleEndian.Uint64(trailer)
+	indexOffset := binary.LittleEndian.Uint64(trailer[8:])
+	nSentences := binary.LittleEndian.Uint64(trailer[16:])
+
+	indexEnd := uint64(len(data) - trailerSize)
+	if tablesOffset < uint64(headerSize) || tablesOffset > indexOffset || indexOffset > indexEnd ||
+		(indexEnd-indexOffset)/8 != nSentences || (indexEnd-indexOffset)%8 != 0 {
+		return nil, errCorrupt
+	}
+
+	corpus := &Corpus{
+		data:        data,
+		index:       data[indexOffset:indexEnd],
+		nSentences:  int(nSentences),
+		sentenceEnd: tablesOffset,
+	}
+
+	if err := corpus.readTables(data[tablesOffset:indexOffset]); err != nil {
+		return nil, err
+	}
+
+	return corpus, nil
+}
+
+func (c *Corpus) readTables(data []byte) error {
+	d := decoder{data: data}
+
+	for table := range c.tables {
+		n := d.uvarint()
+		if n > uint64(len(data)) {
+			return errCorrupt
+		}
+
+		strings := make([]string, n)
+		for idx := range strings {
+			strings[idx] = string(d.bytes(d.uvarint()))
+		}
+
+		if d.err != nil {
+			return d.err
+		}
+
+		c.tables[table] = strings
+	}
+
+	return nil
+}
+
+// Close releases the resources of the corpus. Sentences that were
+// retrieved remain valid.
+func (c *Corpus) Close() error {
+	if c.closer == nil {
+		return nil
+	}
+
+	closer := c.closer
+	c.closer = nil
+	c.data, c.index = nil, nil
+
+	return closer()
+}
+
+// Len returns the number of sentences in the corpus.
+func (c *Corpus) Len() int {
+	return c.nSentences
+}
+
+// Sentence returns the sentence with the given number, starting at 0.
+func (c *Corpus) Sentence(n int) (conllx.Sentence, error) {
+	if n < 0 || n >= c.nSentences {
+		return nil, fmt.Errorf("Sentence number out of bounds: %d", n)
+	}
+
+	if c.data == nil {
+		return nil, errors.New("Binary corpus is closed")
+	}
+
+	offset := binary.LittleEndian.Uint64(c.index[n*8:])
+	if offset < uint64(headerSize) || offset >= c.sentenceEnd {
+		return nil, errCorrupt
+	}
+
+	return c.decodeSentence(c.data[offset:c.sentenceEnd])
+}
+
+func (c *Corpus) decodeSentence(data []byte) (conllx.Sentence, error) {
+	d := decoder{data: data}
+
+	n := d.uvarint()
+	if n > uint64(len(data)) {
+		return nil, errCorrupt
+	}
+
+	sentence := make(conllx.Sentence, n)
+	for idx := range sentence {
+		token := &sentence[idx]
+		mask := d.uvarint()
+
+		for _, l := range stringLayers {
+			if mask&l.bit != 0 {
+				token.SetLayer(l.layer, c.lookup(&d, l.table))
+			}
+		}
+
+		if mask&headBit != 0 {
+			token.SetHead(uint(d.uvarint()))
+		}
+
+		if mask&pHeadBit != 0 {
+			token.SetPHead(uint(d.uvarint()))
+		}
+
+		if mask&extraBit != 0 {
+			nExtra := d.uvarint()
+			if nExtra > uint64(len(data)) {
+				return nil, errCorrupt
+			}
+
+			extra := make([]string, nExtra)
+			for i := range extra {
+				extra[i] = c.lookup(&d, extraTable)
+			}
+			token.SetExtraColumns(extra...)
+		}
+	}
+
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	return sentence, nil
+}
+
+func (c *Corpus) lookup(d *decoder, table int) string {
+	id := d.uvarint()
+	if id >= uint64(len(c.tables[table])) {
+		d.err = errCorrupt
+		return ""
+	}
+
+	return c.tables[table][id]
+}
+
+// Reader returns a reader that reads the sentences of the corpus
+// sequentially.
+func (c *Corpus) Reader() *Reader {
+	return &Reader{corpus: c}
+}
+
+var _ conllx.SentenceReader = &Reader{}
+
+// A Reader reads the sentences of a binary corpus sequentially.
+type Reader struct {
+	corpus *Corpus
+	next   int
+}
+
+// ReadSentence returns the next sentence. If there is no more data
+// that can be read, io.EOF is returned as the error.
+func (r *Reader) ReadSentence() (conllx.Sentence, error) {
+	if r.next >= r.corpus.Len() {
+		return nil, io.EOF
+	}
+
+	sentence, err := r.corpus.Sentence(r.next)
+	if err != nil {
+		return nil, err
+	}
+
+	r.next++
+
+	return sentence, nil
+}
+
+// decoder reads varints and byte strings, recording the first error.
+type decoder struct {
+	data []byte
+	err  error
+}
+
+func (d *decoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+
+	v, n := binary.Uvarint(d.data)
+	if n <= 0 {
+		d.err = errCorrupt
+		return 0
+	}
+
+	d.data = d.data[n:]
+
+	return v
+}
+
+func (d *decoder) bytes(n uint64) []byte {
+	if d.err != nil {
+		return nil
+	}
+
+	if n > uint64(len(d.data)) {
+		d.err = errCorrupt
+		return nil
+	}
+
+	b := d.data[:n]
+	d.data = d.data[n:]
+
+	return b
+}
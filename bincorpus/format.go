@@ -0,0 +1,82 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package bincorpus stores sentences in a compact binary format that
+// supports sequential reading and random access by sentence number.
+//
+// A corpus file consists of:
+//
+//   - A header with the magic string and the format version.
+//   - The sentences. Every token is stored as a bit mask of the layers
+//     that are present, followed by the string table identifiers of the
+//     present string layers, the heads, and the extra columns. All
+//     integers are unsigned varints.
+//   - The string tables, one table per kind of layer (forms, lemmas,
+//     tags, features, relations, and extra columns).
+//   - The index, a little-endian uint64 offset per sentence.
+//   - A trailer with the offsets of the string tables and the index,
+//     the number of sentences, and the magic string.
+//
+// Since the index has fixed-width entries, sentences can be retrieved
+// in constant time. Files are memory-mapped on Unix systems.
+package bincorpus
+
+import (
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+const (
+	magic      = "CNLXBIN\x00"
+	version    = 1
+	headerSize = len(magic) + 4
+
+	// Trailer: string tables offset, index offset, number of sentences,
+	// and the magic string.
+	trailerSize = 3*8 + len(magic)
+)
+
+// Identifiers of the string tables.
+const (
+	formTable = iota
+	lemmaTable
+	coarsePosTagTable
+	posTagTable
+	featuresTable
+	headRelTable
+	pHeadRelTable
+	extraTable
+	nTables
+)
+
+// Bits of the token layer mask.
+const (
+	formBit uint64 = 1 << iota
+	lemmaBit
+	coarsePosTagBit
+	posTagBit
+	featuresBit
+	headBit
+	headRelBit
+	pHeadBit
+	pHeadRelBit
+	extraBit
+)
+
+// stringLayer is a string layer that is stored in a string table.
+type stringLayer struct {
+	bit   uint64
+	table int
+	layer conllx.Layer
+}
+
+// The string layers in the order in which they are stored.
+var stringLayers = []stringLayer{
+	{formBit, formTable, conllx.FormLayer},
+	{lemmaBit, lemmaTable, conllx.LemmaLayer},
+	{coarsePosTagBit, coarsePosTagTable, conllx.CoarsePosTagLayer},
+	{posTagBit, posTagTable, conllx.PosTagLayer},
+	{featuresBit, featuresTable, conllx.FeaturesLayer},
+	{headRelBit, headRelTable, conllx.HeadRelLayer},
+	{pHeadRelBit, pHeadRelTable, conllx.PHeadRelLayer},
+}
@@ -0,0 +1,21 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !unix
+
+package bincorpus
+
+import (
+	"os"
+)
+
+// mapFile reads a file into memory, on systems without mmap support.
+func mapFile(path string) ([]byte, func() error, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return data, func() error { return nil }, nil
+}
@@ -0,0 +1,43 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build unix
+
+package bincorpus
+
+import (
+	"errors"
+	"os"
+	"syscall"
+)
+
+// mapFile memory-maps a file read-only.
+func mapFile(path string) ([]byte, func() error, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	size := info.Size()
+	if size == 0 {
+		return nil, nil, errors.New("Data is not a binary corpus")
+	}
+
+	if int64(int(size)) != size {
+		return nil, nil, errors.New("Binary corpus is too large to map")
+	}
+
+	data, err := syscall.Mmap(int(f.Fd()), 0, int(size), syscall.PROT_READ, syscall.MAP_SHARED)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return data, func() error { return syscall.Munmap(data) }, nil
+}
@@ -0,0 +1,215 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bincorpus
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+var _ conllx.SentenceWriter = &Writer{}
+
+// A Writer writes sentences in the binary corpus format. The string
+// tables and the index are kept in memory and written by Close.
+type Writer struct {
+	writer  *bufio.Writer
+	offset  uint64
+	tables  [nTables]stringTable
+	index   []uint64
+	buf     []byte
+	err     error
+	started bool
+	closed  bool
+}
+
+type stringTable struct {
+	ids     map[string]uint64
+	strings []string
+}
+
+func (t *stringTable) id(s string) uint64 {
+	if t.ids == nil {
+		t.ids = make(map[string]uint64)
+	}
+
+	id, ok := t.ids[s]
+	if !ok {
+		id = uint64(len(t.strings))
+		t.ids[s] = id
+		t.strings = append(t.strings, s)
+	}
+
+	return id
+}
+
+// NewWriter creates a writer for the binary corpus format. Close must
+// be called to finish the corpus. The caller is responsible for closing
+// the provided writer.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{
+		writer: bufio.NewWriter(w),
+	}
+}
+
+func (w *Writer) write(data []byte) {
+	if w.err != nil {
+		return
+	}
+
+	_, w.err = w.writer.Write(data)
+	w.offset += uint64(len(data))
+}
+
+func (w *Writer) writeHeader() {
+	if w.started {
+		return
+	}
+	w.started = true
+
+	header := make([]byte, headerSize)
+	copy(header, magic)
+	binary.LittleEndian.PutUint32(header[len(magic):], version)
+	w.write(header)
+}
+
+// WriteSentence writes a sentence.
+func (w *Writer) WriteSentence(sentence conllx.Sentence) error {
+	if w.closed {
+		return errors.New("Cannot write to a closed corpus writer")
+	}
+
+	w.writeHeader()
+	w.index = append(w.index, w.offset)
+
+	buf := binary.AppendUvarint(w.buf[:0], uint64(len(sentence)))
+	for idx := range sentence {
+		buf = w.appendToken(buf, &sentence[idx])
+	}
+	w.buf = buf
+
+	w.write(buf)
+
+	return w.err
+}
+
+func (w *Writer) appendToken(buf []byte, token *conllx.Token) []byte {
+	var mask uint64
+	for _, l := range stringLayers {
+		if _, ok := token.Layer(l.layer); ok {
+			mask |= l.bit
+		}
+	}
+
+	head, hasHead := token.Head()
+	if hasHead {
+		mask |= headBit
+	}
+
+	pHead, hasPHead := token.PHead()
+	if hasPHead {
+		mask |= pHeadBit
+	}
+
+	extra := token.ExtraColumns()
+	if len(extra) != 0 {
+		mask |= extraBit
+	}
+
+	buf = binary.AppendUvarint(buf, mask)
+
+	for _, l := range stringLayers {
+		if value, ok := token.Layer(l.layer); ok {
+			buf = binary.AppendUvarint(buf, w.tables[l.table].id(value))
+		}
+	}
+
+	if hasHead {
+		buf = binary.AppendUvarint(buf, uint64(head))
+	}
+
+	if hasPHead {
+		buf = binary.AppendUvarint(buf, uint64(pHead))
+	}
+
+	if len(extra) != 0 {
+		buf = binary.AppendUvarint(buf, uint64(len(extra)))
+		for _, column := range extra {
+			buf = binary.AppendUvarint(buf, w.tables[extraTable].id(column))
+		}
+	}
+
+	return buf
+}
+
+// Close writes the string tables, the index, and the trailer. The
+// underlying writer is not closed.
+func (w *Writer) Close() error {
+	if w.closed {
+		return errors.New("Corpus writer is already closed")
+	}
+	w.closed = true
+
+	w.writeHeader()
+
+	tablesOffset := w.offset
+	for _, table := range w.tables {
+		buf := binary.AppendUvarint(w.buf[:0], uint64(len(table.strings)))
+		for _, s := range table.strings {
+			buf = binary.AppendUvarint(buf, uint64(len(s)))
+			buf = append(buf, s...)
+		}
+		w.buf = buf
+
+		w.write(buf)
+	}
+
+	indexOffset := w.offset
+	entry := make([]byte, 8)
+	for _, offset := range w.index {
+		binary.LittleEndian.PutUint64(entry, offset)
+		w.write(entry)
+	}
+
+	trailer := make([]byte, trailerSize)
+	binary.LittleEndian.PutUint64(trailer, tablesOffset)
+	binary.LittleEndian.PutUint64(trailer[8:], indexOffset)
+	binary.LittleEndian.PutUint64(trailer[16:], uint64(len(w.index)))
+	copy(trailer[24:], magic)
+	w.write(trailer)
+
+	if w.err != nil {
+		return w.err
+	}
+
+	return w.writer.Flush()
+}
+
+// Convert writes all sentences of 'reader' to 'w' in the binary corpus
+// format. The number of sentences is returned.
+func Convert(reader conllx.SentenceReader, w io.Writer) (int, error) {
+	writer := NewWriter(w)
+
+	n := 0
+	for {
+		sentence, err := reader.ReadSentence()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return n, err
+		}
+
+		if err := writer.WriteSentence(sentence); err != nil {
+			return n, err
+		}
+		n++
+	}
+
+	return n, writer.Close()
+}
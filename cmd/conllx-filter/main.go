@@ -0,0 +1,154 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// conllx-filter writes the sentences of CoNLL-X corpora that satisfy
+// all the given conditions to the standard output. If no corpus is
+// given, the corpus is read from the standard input.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+
+	"gopkg.in/danieldk/conllx.v1"
+	"gopkg.in/danieldk/conllx.v1/filter"
+)
+
+var (
+	minLength  = flag.Int("min", 0, "minimum sentence length")
+	maxLength  = flag.Int("max", 0, "maximum sentence length (0: unbounded)")
+	layers     = flag.String("layers", "", "comma-separated layers that every token must have")
+	tree       = flag.Bool("tree", false, "only keep valid dependency trees")
+	projective = flag.Bool("projective", false, "only keep projective dependency trees")
+	match      = flag.String("match", "", "only keep sentences with a form that matches the regular expression")
+	noPunct    = flag.Bool("no-punct", false, "remove sentences that only consist of punctuation")
+	dedup      = flag.Bool("dedup", false, "remove sentences with a form sequence that was seen before")
+	invert     = flag.Bool("v", false, "write the sentences that do not satisfy the conditions")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: %s [OPTIONS] [CORPUS...]\n\n", os.Args[0])
+	flag.PrintDefaults()
+}
+
+func predicate() (filter.Predicate, error) {
+	var predicates []filter.Predicate
+
+	if *minLength > 0 {
+		predicates = append(predicates, filter.MinLength(*minLength))
+	}
+
+	if *maxLength > 0 {
+		predicates = append(predicates, filter.MaxLength(*maxLength))
+	}
+
+	if *layers != "" {
+		var required []conllx.Layer
+		for _, name := range strings.Split(*layers, ",") {
+			layer, err := conllx.ParseLayer(strings.TrimSpace(name))
+			if err != nil {
+				return nil, err
+			}
+			required = append(required, layer)
+		}
+
+		predicates = append(predicates, filter.HasLayers(required...))
+	}
+
+	if *tree {
+		predicates = append(predicates, filter.IsTree())
+	}
+
+	if *projective {
+		predicates = append(predicates, filter.IsProjective())
+	}
+
+	if *match != "" {
+		re, err := regexp.Compile(*match)
+		if err != nil {
+			return nil, err
+		}
+
+		predicates = append(predicates, filter.AnyFormMatches(re))
+	}
+
+	if *noPunct {
+		predicates = append(predicates, filter.Not(filter.PunctuationOnly()))
+	}
+
+	// Deduplication comes last, so that only sentences that satisfy the
+	// other conditions are recorded.
+	if *dedup {
+		predicates = append(predicates, filter.NewDeduplicator().Unique())
+	}
+
+	p := filter.And(predicates...)
+	if *invert {
+		p = filter.Not(p)
+	}
+
+	return p, nil
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	p, err := predicate()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var readers []conllx.SentenceReader
+	if flag.NArg() == 0 {
+		readers = append(readers, conllx.NewReader(bufio.NewReader(os.Stdin)))
+	}
+
+	for _, filename := range flag.Args() {
+		f, err := os.Open(filename)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+
+		readers = append(readers, conllx.NewReader(bufio.NewReader(f)))
+	}
+
+	reader := filter.NewReader(conllx.NewConcatReader(readers...), p)
+
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+
+	writer := conllx.NewWriter(out)
+	written := false
+
+	for {
+		sentence, err := reader.ReadSentence()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			// log.Fatal exits without running deferred calls.
+			out.Flush()
+			log.Fatal(err)
+		}
+
+		if err := writer.WriteSentence(sentence); err != nil {
+			out.Flush()
+			log.Fatal(err)
+		}
+		written = true
+	}
+
+	// The CoNLL-X writer does not end the last sentence with a newline.
+	if written {
+		fmt.Fprintln(out)
+	}
+}
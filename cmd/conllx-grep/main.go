@@ -0,0 +1,147 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// conllx-grep prints the sentences of CoNLL-X corpora that match a tree
+// query. See the documentation of the query package for the query
+// syntax. If no corpus is given, the corpus is read from the standard
+// input.
+//
+// By default, matching sentences are printed as text, with the matched
+// tokens in brackets or highlighted when colors are enabled. With
+// -conllx, the matching sentences are printed in CoNLL-X format. Without
+// colors, this output can be used as a filtered corpus.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"gopkg.in/danieldk/conllx.v1"
+	"gopkg.in/danieldk/conllx.v1/query"
+)
+
+const (
+	colorStart = "\x1b[1;31m"
+	colorEnd   = "\x1b[0m"
+)
+
+var asConllx = flag.Bool("conllx", false, "print matching sentences in CoNLL-X format")
+var color = flag.Bool("color", false, "highlight matched tokens with colors")
+var invert = flag.Bool("v", false, "print sentences that do not match")
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: %s [OPTIONS] QUERY [CORPUS...]\n\n", os.Args[0])
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	pattern, err := query.Compile(flag.Arg(0))
+	if err != nil {
+		log.Fatalf("Invalid query: %s", err)
+	}
+
+	var readers []conllx.SentenceReader
+	if flag.NArg() == 1 {
+		readers = append(readers, conllx.NewReader(bufio.NewReader(os.Stdin)))
+	}
+
+	for _, filename := range flag.Args()[1:] {
+		f, err := os.Open(filename)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+
+		readers = append(readers, conllx.NewReader(bufio.NewReader(f)))
+	}
+
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+
+	reader := conllx.NewConcatReader(readers...)
+	first := true
+
+	for {
+		sentence, err := reader.ReadSentence()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			// log.Fatal exits without running deferred calls.
+			out.Flush()
+			log.Fatal(err)
+		}
+
+		matches := pattern.FindAll(sentence)
+		if (len(matches) == 0) != *invert {
+			continue
+		}
+
+		matched := make(map[int]bool)
+		for _, match := range matches {
+			for _, idx := range match.Nodes {
+				matched[idx] = true
+			}
+		}
+
+		if *asConllx {
+			if !first {
+				fmt.Fprintln(out)
+			}
+			printConllx(out, sentence, matched)
+		} else {
+			printText(out, sentence, matched)
+		}
+
+		first = false
+	}
+}
+
+func highlight(s string, brackets bool) string {
+	if *color {
+		return colorStart + s + colorEnd
+	}
+
+	if brackets {
+		return "[" + s + "]"
+	}
+
+	return s
+}
+
+func printText(w io.Writer, sentence conllx.Sentence, matched map[int]bool) {
+	forms := make([]string, len(sentence))
+	for idx := range sentence {
+		forms[idx], _ = sentence[idx].Form()
+		if matched[idx] {
+			forms[idx] = highlight(forms[idx], true)
+		}
+	}
+
+	fmt.Fprintln(w, strings.Join(forms, " "))
+}
+
+func printConllx(w io.Writer, sentence conllx.Sentence, matched map[int]bool) {
+	for idx, token := range sentence {
+		line := fmt.Sprintf("%d\t%s", idx+1, token)
+		if matched[idx] {
+			line = highlight(line, false)
+		}
+
+		fmt.Fprintln(w, line)
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// conllx-split splits a CoNLL-X corpus into cross-validation folds or
+// into consecutive train/development/test parts.
+//
+// Cross-validation folds are written to PREFIX.N.train.conll and
+// PREFIX.N.test.conll, where N is the fold number. Consecutive parts are
+// written to PREFIX.train.conll, PREFIX.dev.conll, and PREFIX.test.conll.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+// Names of the parts, by the number of parts.
+var partNames = map[int][]string{
+	1: {"train"},
+	2: {"train", "test"},
+	3: {"train", "dev", "test"},
+}
+
+var folds = flag.Int("folds", 0, "split into the given number of cross-validation folds")
+var assign = flag.String("assign", "roundrobin", "fold assignment: roundrobin, random, or hash")
+var seed = flag.Int64("seed", 42, "seed for random fold assignment")
+var ratios = flag.String("ratios", "", "comma-separated train,dev,test ratios, e.g. 8,1,1")
+var counts = flag.String("counts", "", "comma-separated train,dev sentence counts, remaining sentences are test data")
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: %s [OPTIONS] CORPUS PREFIX\n\n", os.Args[0])
+	flag.PrintDefaults()
+}
+
+type outputFile struct {
+	file   *os.File
+	buf    *bufio.Writer
+	writer *conllx.Writer
+}
+
+func createOutput(filename string) *outputFile {
+	f, err := os.Create(filename)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	buf := bufio.NewWriter(f)
+
+	return &outputFile{
+		file:   f,
+		buf:    buf,
+		writer: conllx.NewWriter(buf),
+	}
+}
+
+func (o *outputFile) Close() {
+	if err := o.buf.Flush(); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := o.file.Close(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func closeAll(outputs []*outputFile) {
+	for _, output := range outputs {
+		output.Close()
+	}
+}
+
+func sentenceWriters(outputs []*outputFile) []conllx.SentenceWriter {
+	writers := make([]conllx.SentenceWriter, len(outputs))
+	for idx, output := range outputs {
+		writers[idx] = output.writer
+	}
+
+	return writers
+}
+
+func newAssigner() conllx.FoldAssigner {
+	switch *assign {
+	case "roundrobin":
+		return conllx.NewRoundRobinAssigner()
+	case "random":
+		return conllx.NewRandomAssigner(*seed)
+	case "hash":
+		return conllx.NewHashAssigner(nil)
+	default:
+		log.Fatalf("Unknown fold assignment: %s", *assign)
+	}
+
+	return nil
+}
+
+func splitFolds(reader conllx.SentenceReader, prefix string) {
+	train := make([]*outputFile, *folds)
+	test := make([]*outputFile, *folds)
+	for fold := 0; fold < *folds; fold++ {
+		train[fold] = createOutput(fmt.Sprintf("%s.%d.train.conll", prefix, fold))
+		test[fold] = createOutput(fmt.Sprintf("%s.%d.test.conll", prefix, fold))
+	}
+
+	err := conllx.WriteFolds(reader, newAssigner(), sentenceWriters(train), sentenceWriters(test))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	closeAll(train)
+	closeAll(test)
+}
+
+func parseList(list string) []float64 {
+	var values []float64
+	for _, field := range strings.Split(list, ",") {
+		value, err := strconv.ParseFloat(strings.TrimSpace(field), 64)
+		if err != nil {
+			log.Fatalf("Cannot parse list '%s': %s", list, err)
+		}
+		values = append(values, value)
+	}
+
+	return values
+}
+
+func parseCounts(list string) []int {
+	var counts []int
+	for _, field := range strings.Split(list, ",") {
+		count, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil {
+			log.Fatalf("Cannot parse counts '%s': %s", list, err)
+		}
+		if count < 0 {
+			log.Fatalf("Counts should not be negative: %d", count)
+		}
+		counts = append(counts, count)
+	}
+
+	return counts
+}
+
+func createParts(prefix string, n int) []*outputFile {
+	names, ok := partNames[n]
+	if !ok {
+		log.Fatalf("At most %d parts are supported", len(partNames))
+	}
+
+	outputs := make([]*outputFile, n)
+	for idx, name := range names {
+		outputs[idx] = createOutput(fmt.Sprintf("%s.%s.conll", prefix, name))
+	}
+
+	return outputs
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	if (*folds > 0) == (*ratios != "" || *counts != "") || (*ratios != "" && *counts != "") {
+		log.Fatal("Exactly one of -folds, -ratios, or -counts should be used")
+	}
+
+	f, err := os.Open(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	reader := conllx.NewReader(bufio.NewReader(f))
+	prefix := flag.Arg(1)
+
+	switch {
+	case *folds > 0:
+		splitFolds(reader, prefix)
+	case *ratios != "":
+		partRatios := parseList(*ratios)
+		outputs := createParts(prefix, len(partRatios))
+		if err := conllx.SplitRatios(reader, partRatios, sentenceWriters(outputs)); err != nil {
+			log.Fatal(err)
+		}
+		closeAll(outputs)
+	default:
+		partCounts := append(parseCounts(*counts), -1)
+
+		outputs := createParts(prefix, len(partCounts))
+		if err := conllx.SplitCounts(reader, partCounts, sentenceWriters(outputs)); err != nil {
+			log.Fatal(err)
+		}
+		closeAll(outputs)
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// conllx-stats prints statistics of CoNLL-X corpora. If no corpus is
+// given, the corpus is read from the standard input.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"gopkg.in/danieldk/conllx.v1"
+	"gopkg.in/danieldk/conllx.v1/stats"
+)
+
+var asJSON = flag.Bool("json", false, "print statistics as JSON")
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: %s [OPTIONS] [CORPUS...]\n\n", os.Args[0])
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	var readers []conllx.SentenceReader
+	if flag.NArg() == 0 {
+		readers = append(readers, conllx.NewReader(bufio.NewReader(os.Stdin)))
+	}
+
+	for _, filename := range flag.Args() {
+		f, err := os.Open(filename)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+
+		readers = append(readers, conllx.NewReader(bufio.NewReader(f)))
+	}
+
+	s, err := stats.Collect(conllx.NewConcatReader(readers...))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(s.Summary())
+	} else {
+		err = s.WriteTable(os.Stdout)
+	}
+
+	if err != nil {
+		log.Fatal(err)
+	}
+}
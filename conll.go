@@ -31,8 +31,9 @@ type Features struct {
 	featuresMap    map[string]string
 }
 
-// Construct a new features field from a features string.
-func newFeatures(featuresString string) *Features {
+// NewFeatures constructs a features field from a features string in the
+// CoNLL-X format, e.g. case:nominative|number:singular.
+func NewFeatures(featuresString string) *Features {
 	return &Features{
 		featuresString: featuresString,
 		featuresMap:    nil,
@@ -63,6 +64,27 @@ func (f *Features) FeaturesMap() map[string]string {
 	return f.featuresMap
 }
 
+// Clone returns a deep copy of the features. The feature map of the
+// copy, if initialized, does not share storage with the original.
+func (f *Features) Clone() *Features {
+	if f == nil {
+		return nil
+	}
+
+	var featuresMap map[string]string
+	if f.featuresMap != nil {
+		featuresMap = make(map[string]string, len(f.featuresMap))
+		for k, v := range f.featuresMap {
+			featuresMap[k] = v
+		}
+	}
+
+	return &Features{
+		featuresString: f.featuresString,
+		featuresMap:    featuresMap,
+	}
+}
+
 var _ fmt.Stringer = Token{}
 
 // Token stores a token with the CONLL-X annotation layers.
@@ -77,6 +99,7 @@ type Token struct {
 	headRel      string
 	pHead        uint
 	pHeadRel     string
+	extra        []string
 }
 
 // NewToken creates a new Token with all layers set to absent.
@@ -147,6 +170,26 @@ func (t *Token) PHeadRel() (string, bool) {
 	return t.pHeadRel, t.available&pHeadRelBit != 0
 }
 
+// ExtraColumns returns the columns after the ten CoNLL-X columns,
+// verbatim. Absent values in these columns are stored as underscores
+// (_). The returned slice must not be modified, use SetExtraColumns or
+// SetExtraColumn to change the columns.
+func (t *Token) ExtraColumns() []string {
+	return t.extra
+}
+
+// ExtraColumn returns the extra column with the given index, where
+// index 0 is the eleventh column. The second tuple element is false
+// when the token does not have the column or when its value is an
+// underscore (_).
+func (t *Token) ExtraColumn(idx int) (string, bool) {
+	if idx < 0 || idx >= len(t.extra) || t.extra[idx] == "_" {
+		return "", false
+	}
+
+	return t.extra[idx], true
+}
+
 // SetFeatures sets the features for this token. The token itself is
 // returned to allow method chaining.
 func (t *Token) SetFeatures(features map[string]string) *Token {
@@ -230,6 +273,50 @@ func (t *Token) SetPHeadRel(rel string) *Token {
 	return t
 }
 
+// SetExtraColumns replaces the columns after the ten CoNLL-X columns.
+// The token itself is returned to allow method chaining.
+func (t *Token) SetExtraColumns(columns ...string) *Token {
+	if len(columns) == 0 {
+		t.extra = nil
+	} else {
+		t.extra = append([]string(nil), columns...)
+	}
+
+	return t
+}
+
+// SetExtraColumn sets the extra column with the given index, where
+// index 0 is the eleventh column. Missing columns before the index are
+// added as underscores (_). Negative indices are ignored. The token
+// itself is returned to allow method chaining.
+func (t *Token) SetExtraColumn(idx int, value string) *Token {
+	if idx < 0 {
+		return t
+	}
+
+	// Copy, the backing array can be shared with a clone.
+	extra := append([]string(nil), t.extra...)
+	for len(extra) <= idx {
+		extra = append(extra, "_")
+	}
+
+	extra[idx] = value
+	t.extra = extra
+
+	return t
+}
+
+// Clone returns a deep copy of the token. Modifying the features or
+// extra columns of the copy does not affect the original token and vice
+// versa.
+func (t Token) Clone() Token {
+	t.features = t.features.Clone()
+	if t.extra != nil {
+		t.extra = append([]string(nil), t.extra...)
+	}
+	return t
+}
+
 func (t Token) String() string {
 	var buffer bytes.Buffer
 
@@ -251,6 +338,11 @@ func (t Token) String() string {
 	buffer.WriteRune('\t')
 	buffer.WriteString(stringForField(t.PHeadRel))
 
+	for _, column := range t.extra {
+		buffer.WriteRune('\t')
+		buffer.WriteString(column)
+	}
+
 	return buffer.String()
 }
 
@@ -283,6 +375,22 @@ var _ fmt.Stringer = Sentence{}
 // A Sentence is a slice of Tokens.
 type Sentence []Token
 
+// Clone returns a deep copy of the sentence. Since the copy does not
+// share any storage with the original sentence, it can be retained
+// across calls of ReadSentence and modified freely.
+func (s Sentence) Clone() Sentence {
+	if s == nil {
+		return nil
+	}
+
+	sentenceCopy := make(Sentence, len(s))
+	for idx, token := range s {
+		sentenceCopy[idx] = token.Clone()
+	}
+
+	return sentenceCopy
+}
+
 func (s Sentence) String() string {
 	var buf bytes.Buffer
 
@@ -0,0 +1,133 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package conll09 reads and writes the CoNLL-2009 and CoNLL-2008 shared
+// task formats, which combine dependency syntax with semantic
+// predicate-argument structure.
+//
+// The syntactic layers are stored as conllx sentences: one sentence
+// with the gold-standard layers and one sentence with the predicted
+// layers. The predicate-argument structure is stored separately.
+package conll09
+
+import (
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+// A Format is a column layout.
+type Format int
+
+const (
+	// CoNLL2009 is the layout of the CoNLL-2009 shared task: ID, FORM,
+	// LEMMA, PLEMMA, POS, PPOS, FEAT, PFEAT, HEAD, PHEAD, DEPREL,
+	// PDEPREL, FILLPRED, PRED, and a column APRED per predicate.
+	CoNLL2009 Format = iota
+
+	// CoNLL2008 is the layout of the CoNLL-2008 shared task: ID, FORM,
+	// LEMMA, GPOS, PPOS, SPLIT_FORM, SPLIT_LEMMA, PPOSS, HEAD, DEPREL,
+	// PRED, and a column ARG per predicate.
+	CoNLL2008
+)
+
+// fixedColumns returns the number of columns before the argument
+// columns.
+func (f Format) fixedColumns() int {
+	if f == CoNLL2008 {
+		return 11
+	}
+
+	return 14
+}
+
+// A Sentence is a sentence with syntactic and semantic annotations.
+//
+// In the CoNLL-2009 format, the gold sentence has the form, lemma,
+// part-of-speech, features, head, and head relation layers. The
+// predicted sentence has the same layers, read from the PLEMMA, PPOS,
+// PFEAT, PHEAD, and PDEPREL columns.
+//
+// In the CoNLL-2008 format, the gold sentence has the form, lemma,
+// part-of-speech (GPOS), head, and head relation layers. The predicted
+// sentence has the form and part-of-speech (PPOS) layers. The Split
+// sentence has the form, lemma, and part-of-speech layers of the
+// SPLIT_FORM, SPLIT_LEMMA, and PPOSS columns.
+type Sentence struct {
+	Gold       conllx.Sentence
+	Predicted  conllx.Sentence
+	Split      conllx.Sentence
+	Predicates []Predicate
+}
+
+// A Predicate is a predicate with its semantic arguments.
+type Predicate struct {
+	// Token is the index of the predicate, starting at 1.
+	Token uint
+
+	// Sense is the predicate sense, such as eat.01. The sense is empty
+	// when the predicate is marked without a sense (in CoNLL-2009 test
+	// data, where FILLPRED is Y and PRED is absent).
+	Sense string
+
+	// Arguments are the arguments of the predicate in sentence order.
+	Arguments []Argument
+}
+
+// An Argument is a semantic argument of a predicate.
+type Argument struct {
+	// Token is the index of the argument head, starting at 1.
+	Token uint
+
+	// Label is the semantic role, such as A0.
+	Label string
+}
+
+// Len returns the number of tokens in the sentence.
+func (s *Sentence) Len() int {
+	return len(s.Gold)
+}
+
+// Predicate returns the predicate at the given token index (starting
+// at 1), if any.
+func (s *Sentence) Predicate(token uint) (*Predicate, bool) {
+	for idx := range s.Predicates {
+		if s.Predicates[idx].Token == token {
+			return &s.Predicates[idx], true
+		}
+	}
+
+	return nil, false
+}
+
+// Argument returns the label of the argument at the given token index
+// (starting at 1), if the token is an argument of the predicate.
+func (p *Predicate) Argument(token uint) (string, bool) {
+	for _, arg := range p.Arguments {
+		if arg.Token == token {
+			return arg.Label, true
+		}
+	}
+
+	return "", false
+}
+
+// An Annotation selects the gold-standard or predicted syntactic
+// layers of a sentence.
+type Annotation int
+
+const (
+	// GoldAnnotation selects the gold-standard layers.
+	GoldAnnotation Annotation = iota
+
+	// PredictedAnnotation selects the predicted layers.
+	PredictedAnnotation
+)
+
+// Syntax returns the syntactic layers of the given annotation.
+func (s *Sentence) Syntax(annotation Annotation) conllx.Sentence {
+	if annotation == PredictedAnnotation {
+		return s.Predicted
+	}
+
+	return s.Gold
+}
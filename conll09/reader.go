@@ -0,0 +1,252 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conll09
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+// A Reader reads sentences in the CoNLL-2009 or CoNLL-2008 format.
+type Reader struct {
+	scanner *bufio.Scanner
+	format  Format
+	line    int
+}
+
+// NewReader creates a new reader for the given format from a buffered
+// I/O reader. The caller is responsible for closing the provided
+// reader.
+func NewReader(r *bufio.Reader, format Format) *Reader {
+	return &Reader{
+		scanner: bufio.NewScanner(r),
+		format:  format,
+	}
+}
+
+func (r *Reader) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("Line %d: %s", r.line, fmt.Sprintf(format, args...))
+}
+
+// ReadSentence returns the next sentence. If there is no more data
+// that can be read, io.EOF is returned as the error.
+func (r *Reader) ReadSentence() (*Sentence, error) {
+	var rows [][]string
+
+	for r.scanner.Scan() {
+		r.line++
+
+		line := strings.TrimSpace(r.scanner.Text())
+		if len(line) == 0 {
+			if len(rows) == 0 {
+				continue
+			}
+
+			break
+		}
+
+		columns := strings.Split(line, "\t")
+		if len(columns) < r.format.fixedColumns() {
+			return nil, r.errorf("Expected at least %d columns, got %d",
+				r.format.fixedColumns(), len(columns))
+		}
+
+		if len(rows) != 0 && len(columns) != len(rows[0]) {
+			return nil, r.errorf("Expected %d columns, got %d", len(rows[0]), len(columns))
+		}
+
+		if id, err := strconv.Atoi(columns[0]); err != nil || id != len(rows)+1 {
+			return nil, r.errorf("Expected token %d, got: %s", len(rows)+1, columns[0])
+		}
+
+		rows = append(rows, columns)
+	}
+
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, io.EOF
+	}
+
+	if r.format == CoNLL2008 {
+		return r.sentence2008(rows)
+	}
+
+	return r.sentence2009(rows)
+}
+
+func (r *Reader) sentence2009(rows [][]string) (*Sentence, error) {
+	sentence := &Sentence{
+		Gold:      make(conllx.Sentence, len(rows)),
+		Predicted: make(conllx.Sentence, len(rows)),
+	}
+
+	for idx, columns := range rows {
+		gold, predicted := &sentence.Gold[idx], &sentence.Predicted[idx]
+
+		setLayer(gold, conllx.FormLayer, columns[1])
+		setLayer(predicted, conllx.FormLayer, columns[1])
+		setLayer(gold, conllx.LemmaLayer, columns[2])
+		setLayer(predicted, conllx.LemmaLayer, columns[3])
+		setLayer(gold, conllx.PosTagLayer, columns[4])
+		setLayer(predicted, conllx.PosTagLayer, columns[5])
+		setLayer(gold, conllx.FeaturesLayer, columns[6])
+		setLayer(predicted, conllx.FeaturesLayer, columns[7])
+
+		if err := setHead(gold, columns[8]); err != nil {
+			return nil, r.errorf("Token %d: %s", idx+1, err)
+		}
+
+		if err := setHead(predicted, columns[9]); err != nil {
+			return nil, r.errorf("Token %d: %s", idx+1, err)
+		}
+
+		setLayer(gold, conllx.HeadRelLayer, columns[10])
+		setLayer(predicted, conllx.HeadRelLayer, columns[11])
+
+		if columns[12] == "Y" || columns[13] != "_" {
+			sentence.Predicates = append(sentence.Predicates, Predicate{
+				Token: uint(idx + 1),
+				Sense: absent(columns[13]),
+			})
+		}
+	}
+
+	if err := r.readArguments(sentence, rows); err != nil {
+		return nil, err
+	}
+
+	return sentence, nil
+}
+
+func (r *Reader) sentence2008(rows [][]string) (*Sentence, error) {
+	sentence := &Sentence{
+		Gold:      make(conllx.Sentence, len(rows)),
+		Predicted: make(conllx.Sentence, len(rows)),
+		Split:     make(conllx.Sentence, len(rows)),
+	}
+
+	for idx, columns := range rows {
+		gold, predicted, split := &sentence.Gold[idx], &sentence.Predicted[idx], &sentence.Split[idx]
+
+		setLayer(gold, conllx.FormLayer, columns[1])
+		setLayer(predicted, conllx.FormLayer, columns[1])
+		setLayer(gold, conllx.LemmaLayer, columns[2])
+		setLayer(gold, conllx.PosTagLayer, columns[3])
+		setLayer(predicted, conllx.PosTagLayer, columns[4])
+		setLayer(split, conllx.FormLayer, columns[5])
+		setLayer(split, conllx.LemmaLayer, columns[6])
+		setLayer(split, conllx.PosTagLayer, columns[7])
+
+		if err := setHead(gold, columns[8]); err != nil {
+			return nil, r.errorf("Token %d: %s", idx+1, err)
+		}
+
+		setLayer(gold, conllx.HeadRelLayer, columns[9])
+
+		if columns[10] != "_" {
+			sentence.Predicates = append(sentence.Predicates, Predicate{
+				Token: uint(idx + 1),
+				Sense: columns[10],
+			})
+		}
+	}
+
+	if err := r.readArguments(sentence, rows); err != nil {
+		return nil, err
+	}
+
+	return sentence, nil
+}
+
+// readArguments reads the argument columns. There is one argument
+// column per predicate, in the order of the predicates.
+func (r *Reader) readArguments(sentence *Sentence, rows [][]string) error {
+	nArgColumns := len(rows[0]) - r.format.fixedColumns()
+	if nArgColumns != len(sentence.Predicates) {
+		return fmt.Errorf("Sentence ending at line %d has %d predicates, but %d argument columns",
+			r.line, len(sentence.Predicates), nArgColumns)
+	}
+
+	for idx, columns := range rows {
+		for pred, label := range columns[r.format.fixedColumns():] {
+			if label == "_" {
+				continue
+			}
+
+			sentence.Predicates[pred].Arguments = append(sentence.Predicates[pred].Arguments,
+				Argument{Token: uint(idx + 1), Label: label})
+		}
+	}
+
+	return nil
+}
+
+// absent returns an empty string for the absent value (_).
+func absent(value string) string {
+	if value == "_" {
+		return ""
+	}
+
+	return value
+}
+
+func setLayer(token *conllx.Token, layer conllx.Layer, value string) {
+	if value != "_" {
+		token.SetLayer(layer, value)
+	}
+}
+
+func setHead(token *conllx.Token, value string) error {
+	if value == "_" {
+		return nil
+	}
+
+	head, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return fmt.Errorf("Invalid head: %s", value)
+	}
+
+	token.SetHead(uint(head))
+
+	return nil
+}
+
+var _ conllx.SentenceReader = &SyntaxReader{}
+
+// A SyntaxReader reads the gold-standard or predicted syntactic layers
+// of CoNLL-2009 or CoNLL-2008 sentences as CoNLL-X sentences.
+type SyntaxReader struct {
+	reader     *Reader
+	annotation Annotation
+}
+
+// NewSyntaxReader creates a reader that returns the syntactic layers
+// of the given annotation.
+func NewSyntaxReader(reader *Reader, annotation Annotation) *SyntaxReader {
+	return &SyntaxReader{
+		reader:     reader,
+		annotation: annotation,
+	}
+}
+
+// ReadSentence returns the syntactic layers of the next sentence. If
+// there is no more data that can be read, io.EOF is returned as the
+// error.
+func (r *SyntaxReader) ReadSentence() (conllx.Sentence, error) {
+	sentence, err := r.reader.ReadSentence()
+	if err != nil {
+		return nil, err
+	}
+
+	return sentence.Syntax(r.annotation), nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conll09
+
+import (
+	"bufio"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+const test2009 = "1\tThe\tthe\tthe\tDT\tDT\t_\t_\t2\t2\tNMOD\tNMOD\t_\t_\t_\t_\n" +
+	"2\tcat\tcat\tcat\tNN\tNN\t_\t_\t3\t3\tSBJ\tSBJ\t_\t_\tA0\t_\n" +
+	"3\tate\teat\teat\tVBD\tVBD\tTense=Past\t_\t0\t0\tROOT\tROOT\tY\teat.01\t_\t_\n" +
+	"4\tquickly\tquickly\tquick\tRB\tJJ\t_\t_\t3\t5\tMNR\tNMOD\tY\t_\tAM-MNR\t_\n" +
+	"5\tfish\tfish\tfish\tNN\tNN\t_\t_\t3\t3\tOBJ\tOBJ\t_\t_\tA1\t_\n" +
+	"\n" +
+	"1\tRun\trun\trun\tVB\tVB\t_\t_\t0\t0\tROOT\tROOT\tY\trun.02\t_"
+
+const test2008 = "1\tNew\tnew\tNNP\tNNP\tNew\tnew\tNNP\t2\tNAME\t_\t_\n" +
+	"2\tYork-based\tyork-based\tJJ\tJJ\tYork\tyork\tNNP\t3\tNMOD\t_\tA1\n" +
+	"3\tfirms\tfirm\tNNS\tNNS\tfirms\tfirm\tNNS\t0\tROOT\tfirm.01\t_"
+
+func TestReader2009(t *testing.T) {
+	reader := NewReader(bufio.NewReader(strings.NewReader(test2009)), CoNLL2009)
+
+	sentence, err := reader.ReadSentence()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	expectedPreds := []Predicate{
+		{Token: 3, Sense: "eat.01", Arguments: []Argument{{2, "A0"}, {4, "AM-MNR"}, {5, "A1"}}},
+		{Token: 4},
+	}
+	if !reflect.DeepEqual(sentence.Predicates, expectedPreds) {
+		t.Fatalf("Expected predicates:\n%v\ngot:\n%v", expectedPreds, sentence.Predicates)
+	}
+
+	if head, _ := sentence.Gold[3].Head(); head != 3 {
+		t.Fatal("Expected gold head 3, got:", head)
+	}
+	if head, _ := sentence.Predicted[3].Head(); head != 5 {
+		t.Fatal("Expected predicted head 5, got:", head)
+	}
+	if tag, _ := sentence.Predicted[3].PosTag(); tag != "JJ" {
+		t.Fatal("Expected predicted tag JJ, got:", tag)
+	}
+	if features, _ := sentence.Gold[2].Layer(conllx.FeaturesLayer); features != "Tense=Past" {
+		t.Fatal("Expected gold features Tense=Past, got:", features)
+	}
+	if _, ok := sentence.Predicted[2].Features(); ok {
+		t.Fatal("Absent predicted features should not be set")
+	}
+
+	sentence, err = reader.ReadSentence()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if !reflect.DeepEqual(sentence.Predicates, []Predicate{{Token: 1, Sense: "run.02"}}) {
+		t.Fatal("Unexpected predicates:", sentence.Predicates)
+	}
+
+	if _, err := reader.ReadSentence(); err != io.EOF {
+		t.Fatal("Expected io.EOF, got:", err)
+	}
+}
+
+func TestReader2008(t *testing.T) {
+	reader := NewReader(bufio.NewReader(strings.NewReader(test2008)), CoNLL2008)
+
+	sentence, err := reader.ReadSentence()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	expectedPreds := []Predicate{{Token: 3, Sense: "firm.01", Arguments: []Argument{{2, "A1"}}}}
+	if !reflect.DeepEqual(sentence.Predicates, expectedPreds) {
+		t.Fatalf("Expected predicates:\n%v\ngot:\n%v", expectedPreds, sentence.Predicates)
+	}
+
+	if form, _ := sentence.Split[1].Form(); form != "York" {
+		t.Fatal("Expected split form York, got:", form)
+	}
+	if rel, _ := sentence.Gold[0].HeadRel(); rel != "NAME" {
+		t.Fatal("Expected relation NAME, got:", rel)
+	}
+}
+
+func TestSyntaxReader(t *testing.T) {
+	reader := NewSyntaxReader(
+		NewReader(bufio.NewReader(strings.NewReader(test2009)), CoNLL2009),
+		PredictedAnnotation)
+
+	sentence, err := reader.ReadSentence()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if lemma, _ := sentence[3].Lemma(); lemma != "quick" {
+		t.Fatal("Expected predicted lemma quick, got:", lemma)
+	}
+}
+
+func TestReaderErrors(t *testing.T) {
+	for _, data := range []string{
+		// Too few columns.
+		"1\tThe\tthe",
+		// More argument columns than predicates.
+		"1\tRun\trun\trun\tVB\tVB\t_\t_\t0\t0\tROOT\tROOT\t_\t_\tA0",
+		// Inconsistent number of columns.
+		"1\tRun\trun\trun\tVB\tVB\t_\t_\t0\t0\tROOT\tROOT\tY\trun.01\t_\n" +
+			"2\t!\t!\t!\t.\t.\t_\t_\t1\t1\tP\tP\t_\t_",
+		// Invalid head.
+		"1\tRun\trun\trun\tVB\tVB\t_\t_\tx\t0\tROOT\tROOT\t_\t_",
+		// Invalid token identifier.
+		"2\tRun\trun\trun\tVB\tVB\t_\t_\t0\t0\tROOT\tROOT\t_\t_",
+	} {
+		reader := NewReader(bufio.NewReader(strings.NewReader(data)), CoNLL2009)
+		if _, err := reader.ReadSentence(); err == nil || err == io.EOF {
+			t.Fatalf("Expected an error for %q, got: %v", data, err)
+		}
+	}
+}
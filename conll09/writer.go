@@ -0,0 +1,213 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conll09
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+// A Writer writes sentences in the CoNLL-2009 or CoNLL-2008 format.
+type Writer struct {
+	first  bool
+	writer io.Writer
+	format Format
+}
+
+// NewWriter creates a new writer for the given format.
+func NewWriter(w io.Writer, format Format) *Writer {
+	return &Writer{
+		first:  true,
+		writer: w,
+		format: format,
+	}
+}
+
+// WriteSentence writes a sentence. For annotation layers that are
+// absent, underscores (_) are written. The gold and predicted
+// sentences, and the split sentence when writing CoNLL-2008, must be
+// empty or have the same length. The argument columns are written in
+// the order of the predicate tokens, since that is the order in which
+// they are read. The CoNLL-2008 format marks predicates by their
+// sense, so predicates without a sense cannot be written in that
+// format.
+func (w *Writer) WriteSentence(sentence *Sentence) error {
+	n := sentence.Len()
+	if n == 0 {
+		n = len(sentence.Predicted)
+	}
+
+	if !lengthMatches(sentence.Gold, n) || !lengthMatches(sentence.Predicted, n) ||
+		(w.format == CoNLL2008 && !lengthMatches(sentence.Split, n)) {
+		return errors.New("Annotation layers have different lengths")
+	}
+
+	predicates := make([]*Predicate, len(sentence.Predicates))
+	for idx := range sentence.Predicates {
+		pred := &sentence.Predicates[idx]
+		if pred.Token == 0 || pred.Token > uint(n) {
+			return fmt.Errorf("Predicate index out of bounds: %d", pred.Token)
+		}
+
+		if w.format == CoNLL2008 && pred.Sense == "" {
+			return fmt.Errorf("Predicate %d has no sense", pred.Token)
+		}
+
+		predicates[idx] = pred
+	}
+
+	sort.SliceStable(predicates, func(i, j int) bool {
+		return predicates[i].Token < predicates[j].Token
+	})
+
+	for idx := 1; idx < len(predicates); idx++ {
+		if predicates[idx].Token == predicates[idx-1].Token {
+			return fmt.Errorf("Token %d has multiple predicates", predicates[idx].Token)
+		}
+	}
+
+	// Sentences are separated by an empty line, without a newline after
+	// the last token of the stream, as in the CoNLL-X writer.
+	if w.first {
+		w.first = false
+	} else {
+		fmt.Fprint(w.writer, "\n\n")
+	}
+
+	for idx := 0; idx < n; idx++ {
+		var columns []string
+		if w.format == CoNLL2008 {
+			columns = w.columns2008(sentence, idx)
+		} else {
+			columns = w.columns2009(sentence, idx)
+		}
+
+		for _, pred := range predicates {
+			label, ok := pred.Argument(uint(idx + 1))
+			if !ok {
+				label = "_"
+			}
+			columns = append(columns, label)
+		}
+
+		line := strings.Join(columns, "\t")
+		if idx != n-1 {
+			line += "\n"
+		}
+
+		if _, err := io.WriteString(w.writer, line); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *Writer) columns2009(sentence *Sentence, idx int) []string {
+	gold, predicted := tokenAt(sentence.Gold, idx), tokenAt(sentence.Predicted, idx)
+
+	form := layer(gold, conllx.FormLayer)
+	if form == "_" {
+		form = layer(predicted, conllx.FormLayer)
+	}
+
+	fillPred, pred := "_", "_"
+	if predicate, ok := sentence.Predicate(uint(idx + 1)); ok {
+		fillPred = "Y"
+		if predicate.Sense != "" {
+			pred = predicate.Sense
+		}
+	}
+
+	return []string{
+		strconv.Itoa(idx + 1),
+		form,
+		layer(gold, conllx.LemmaLayer),
+		layer(predicted, conllx.LemmaLayer),
+		layer(gold, conllx.PosTagLayer),
+		layer(predicted, conllx.PosTagLayer),
+		layer(gold, conllx.FeaturesLayer),
+		layer(predicted, conllx.FeaturesLayer),
+		head(gold),
+		head(predicted),
+		layer(gold, conllx.HeadRelLayer),
+		layer(predicted, conllx.HeadRelLayer),
+		fillPred,
+		pred,
+	}
+}
+
+func (w *Writer) columns2008(sentence *Sentence, idx int) []string {
+	gold, predicted, split := tokenAt(sentence.Gold, idx), tokenAt(sentence.Predicted, idx),
+		tokenAt(sentence.Split, idx)
+
+	form := layer(gold, conllx.FormLayer)
+	if form == "_" {
+		form = layer(predicted, conllx.FormLayer)
+	}
+
+	pred := "_"
+	if predicate, ok := sentence.Predicate(uint(idx + 1)); ok && predicate.Sense != "" {
+		pred = predicate.Sense
+	}
+
+	return []string{
+		strconv.Itoa(idx + 1),
+		form,
+		layer(gold, conllx.LemmaLayer),
+		layer(gold, conllx.PosTagLayer),
+		layer(predicted, conllx.PosTagLayer),
+		layer(split, conllx.FormLayer),
+		layer(split, conllx.LemmaLayer),
+		layer(split, conllx.PosTagLayer),
+		head(gold),
+		layer(gold, conllx.HeadRelLayer),
+		pred,
+	}
+}
+
+func lengthMatches(sentence conllx.Sentence, n int) bool {
+	return len(sentence) == 0 || len(sentence) == n
+}
+
+// tokenAt returns the token at the given index, or nil if the sentence
+// is empty.
+func tokenAt(sentence conllx.Sentence, idx int) *conllx.Token {
+	if len(sentence) == 0 {
+		return nil
+	}
+
+	return &sentence[idx]
+}
+
+func layer(token *conllx.Token, l conllx.Layer) string {
+	if token == nil {
+		return "_"
+	}
+
+	if value, ok := token.Layer(l); ok {
+		return value
+	}
+
+	return "_"
+}
+
+func head(token *conllx.Token) string {
+	if token == nil {
+		return "_"
+	}
+
+	if value, ok := token.Head(); ok {
+		return strconv.FormatUint(uint64(value), 10)
+	}
+
+	return "_"
+}
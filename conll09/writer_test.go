@@ -0,0 +1,125 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conll09
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+func roundTrip(t *testing.T, data string, format Format) {
+	reader := NewReader(bufio.NewReader(strings.NewReader(data)), format)
+
+	var buf bytes.Buffer
+	writer := NewWriter(&buf, format)
+
+	for {
+		sentence, err := reader.ReadSentence()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if err := writer.WriteSentence(sentence); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	if buf.String() != data {
+		t.Fatalf("Expected:\n%s\ngot:\n%s", data, buf.String())
+	}
+}
+
+func TestWriter2009(t *testing.T) {
+	roundTrip(t, test2009, CoNLL2009)
+}
+
+func TestWriter2008(t *testing.T) {
+	roundTrip(t, test2008, CoNLL2008)
+}
+
+func TestWriterErrors(t *testing.T) {
+	gold := conllx.Sentence{*conllx.NewToken().SetForm("Run")}
+
+	for _, sentence := range []*Sentence{
+		{Gold: gold, Predicted: append(gold.Clone(), gold[0])},
+		{Gold: gold, Predicates: []Predicate{{Token: 2}}},
+		{Gold: gold, Predicates: []Predicate{{Token: 1}, {Token: 1}}},
+	} {
+		if err := NewWriter(io.Discard, CoNLL2009).WriteSentence(sentence); err == nil {
+			t.Fatal("Expected an error for sentence:", sentence)
+		}
+	}
+}
+
+func TestWriterPredicateOrder(t *testing.T) {
+	gold := conllx.Sentence{
+		*conllx.NewToken().SetForm("John"),
+		*conllx.NewToken().SetForm("tried"),
+		*conllx.NewToken().SetForm("leaving"),
+	}
+
+	sentence := &Sentence{
+		Gold: gold,
+		Predicates: []Predicate{
+			{Token: 3, Sense: "leave.01", Arguments: []Argument{{1, "A0"}}},
+			{Token: 2, Sense: "try.01", Arguments: []Argument{{1, "A0"}, {3, "A1"}}},
+		},
+	}
+
+	expected := []Predicate{sentence.Predicates[1], sentence.Predicates[0]}
+
+	for _, format := range []Format{CoNLL2009, CoNLL2008} {
+		var buf bytes.Buffer
+		if err := NewWriter(&buf, format).WriteSentence(sentence); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		read, err := NewReader(bufio.NewReader(&buf), format).ReadSentence()
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if !reflect.DeepEqual(read.Predicates, expected) {
+			t.Fatalf("Expected predicates:\n%v\ngot:\n%v", expected, read.Predicates)
+		}
+	}
+}
+
+func TestWriterPredicateWithoutSense(t *testing.T) {
+	sentence := &Sentence{
+		Gold: conllx.Sentence{
+			*conllx.NewToken().SetForm("John"),
+			*conllx.NewToken().SetForm("left"),
+		},
+		Predicates: []Predicate{{Token: 2, Arguments: []Argument{{1, "A0"}}}},
+	}
+
+	if err := NewWriter(io.Discard, CoNLL2008).WriteSentence(sentence); err == nil {
+		t.Fatal("Predicates without a sense should be rejected in the CoNLL-2008 format")
+	}
+
+	var buf bytes.Buffer
+	if err := NewWriter(&buf, CoNLL2009).WriteSentence(sentence); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	read, err := NewReader(bufio.NewReader(&buf), CoNLL2009).ReadSentence()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if !reflect.DeepEqual(read.Predicates, sentence.Predicates) {
+		t.Fatalf("Expected predicates:\n%v\ngot:\n%v", sentence.Predicates, read.Predicates)
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conllx
+
+import (
+	"errors"
+	"io"
+	"math/rand"
+	"sort"
+)
+
+// A Corpus is an in-memory list of sentences.
+type Corpus []Sentence
+
+// ReadCorpus reads all sentences from 'reader' into a corpus. The
+// sentences are copied, so readers that recycle sentences can be used.
+func ReadCorpus(reader SentenceReader) (Corpus, error) {
+	var corpus Corpus
+
+	for {
+		sentence, err := reader.ReadSentence()
+		if err == io.EOF {
+			return corpus, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		corpus = append(corpus, sentence.Clone())
+	}
+}
+
+// Len returns the number of sentences in the corpus.
+func (c Corpus) Len() int {
+	return len(c)
+}
+
+// Tokens returns the number of tokens in the corpus.
+func (c Corpus) Tokens() int {
+	n := 0
+	for _, sentence := range c {
+		n += len(sentence)
+	}
+
+	return n
+}
+
+// Shuffle shuffles the sentences of the corpus in place. The same seed
+// results in the same order.
+func (c Corpus) Shuffle(seed int64) {
+	rng := rand.New(rand.NewSource(seed))
+	rng.Shuffle(len(c), func(i, j int) {
+		c[i], c[j] = c[j], c[i]
+	})
+}
+
+// Sample returns 'n' different sentences of the corpus, drawn
+// uniformly at random without replacement. The sentences are in corpus
+// order. An error is returned if 'n' is negative or if the corpus has
+// fewer than 'n' sentences.
+func (c Corpus) Sample(n int, seed int64) (Corpus, error) {
+	if n < 0 {
+		return nil, errors.New("Sample size is negative")
+	}
+
+	if n > len(c) {
+		return nil, errors.New("Sample size is larger than the corpus")
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	indices := rng.Perm(len(c))[:n]
+	sort.Ints(indices)
+
+	sample := make(Corpus, n)
+	for idx, sentenceIdx := range indices {
+		sample[idx] = c[sentenceIdx]
+	}
+
+	return sample, nil
+}
+
+// SampleWithReplacement returns 'n' sentences of the corpus, drawn
+// uniformly at random with replacement. An empty corpus results in an
+// empty sample. An error is returned if 'n' is negative.
+func (c Corpus) SampleWithReplacement(n int, seed int64) (Corpus, error) {
+	if n < 0 {
+		return nil, errors.New("Sample size is negative")
+	}
+
+	if len(c) == 0 {
+		return nil, nil
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+
+	sample := make(Corpus, n)
+	for idx := range sample {
+		sample[idx] = c[rng.Intn(len(c))]
+	}
+
+	return sample, nil
+}
+
+// Sort sorts the sentences of the corpus in place using 'less'. The
+// sort is stable.
+func (c Corpus) Sort(less func(a, b Sentence) bool) {
+	sort.SliceStable(c, func(i, j int) bool {
+		return less(c[i], c[j])
+	})
+}
+
+// SortByLength sorts the sentences of the corpus in place by their
+// length. Sentences of the same length keep their order.
+func (c Corpus) SortByLength() {
+	c.Sort(func(a, b Sentence) bool {
+		return len(a) < len(b)
+	})
+}
+
+// Batches splits the corpus into batches of at most 'batchSize'
+// sentences. Without bucketing (bucketWidth <= 0), batches are formed
+// in corpus order. With bucketing, only sentences whose lengths fall in
+// the same bucket of 'bucketWidth' lengths are put in the same batch.
+// A batch is formed as soon as its bucket is full, the remaining
+// partial batches follow in the order of their buckets. To obtain
+// different batches in every epoch, shuffle the corpus first. An error
+// is returned if 'batchSize' is not positive.
+func (c Corpus) Batches(batchSize, bucketWidth int) ([]Corpus, error) {
+	if batchSize <= 0 {
+		return nil, errors.New("Batch size should be positive")
+	}
+
+	var batches []Corpus
+	buckets := make(map[int]Corpus)
+
+	for _, sentence := range c {
+		bucket := 0
+		if bucketWidth > 0 {
+			bucket = len(sentence) / bucketWidth
+		}
+
+		buckets[bucket] = append(buckets[bucket], sentence)
+		if len(buckets[bucket]) == batchSize {
+			batches = append(batches, buckets[bucket])
+			buckets[bucket] = nil
+		}
+	}
+
+	keys := make([]int, 0, len(buckets))
+	for bucket := range buckets {
+		keys = append(keys, bucket)
+	}
+	sort.Ints(keys)
+
+	for _, bucket := range keys {
+		if len(buckets[bucket]) != 0 {
+			batches = append(batches, buckets[bucket])
+		}
+	}
+
+	return batches, nil
+}
+
+// Reader returns a reader for the sentences of the corpus. The
+// sentences are not copied.
+func (c Corpus) Reader() *SliceReader {
+	return NewSliceReader(c)
+}
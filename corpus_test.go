@@ -0,0 +1,150 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conllx
+
+import (
+	"reflect"
+	"testing"
+)
+
+func lengthCorpus(lengths ...int) Corpus {
+	corpus := make(Corpus, len(lengths))
+	for idx, length := range lengths {
+		corpus[idx] = make(Sentence, length)
+		for i := range corpus[idx] {
+			corpus[idx][i].SetForm(string(rune('a' + idx)))
+		}
+	}
+
+	return corpus
+}
+
+func corpusLengths(corpus Corpus) []int {
+	lengths := make([]int, len(corpus))
+	for idx, sentence := range corpus {
+		lengths[idx] = len(sentence)
+	}
+
+	return lengths
+}
+
+func TestReadCorpus(t *testing.T) {
+	corpus, err := ReadCorpus(stringReader(testFragment))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if corpus.Len() != 2 || corpus.Tokens() != 4 {
+		t.Fatalf("Unexpected corpus size: %d sentences, %d tokens", corpus.Len(), corpus.Tokens())
+	}
+
+	readerTestHelper(t, corpus.Reader(), [][]Token{testFragmentSent1, testFragmentSent2})
+}
+
+func TestShuffle(t *testing.T) {
+	corpus := lengthCorpus(1, 2, 3, 4, 5, 6, 7, 8)
+	other := lengthCorpus(1, 2, 3, 4, 5, 6, 7, 8)
+
+	corpus.Shuffle(42)
+	other.Shuffle(42)
+
+	if !reflect.DeepEqual(corpus, other) {
+		t.Fatal("Shuffles with the same seed should be equal")
+	}
+
+	if reflect.DeepEqual(corpusLengths(corpus), []int{1, 2, 3, 4, 5, 6, 7, 8}) {
+		t.Fatal("Corpus was not shuffled")
+	}
+
+	corpus.SortByLength()
+	if lengths := corpusLengths(corpus); !reflect.DeepEqual(lengths, []int{1, 2, 3, 4, 5, 6, 7, 8}) {
+		t.Fatal("Corpus was not sorted:", lengths)
+	}
+}
+
+func TestSample(t *testing.T) {
+	corpus := lengthCorpus(1, 2, 3, 4, 5, 6, 7, 8)
+
+	sample, err := corpus.Sample(4, 1)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	lengths := corpusLengths(sample)
+	seen := make(map[int]bool)
+	for idx, length := range lengths {
+		if seen[length] {
+			t.Fatal("Sample without replacement has duplicates:", lengths)
+		}
+		seen[length] = true
+
+		if idx > 0 && lengths[idx-1] > length {
+			t.Fatal("Sample should be in corpus order:", lengths)
+		}
+	}
+
+	if len(lengths) != 4 {
+		t.Fatal("Unexpected sample size:", len(lengths))
+	}
+
+	if _, err := corpus.Sample(9, 1); err == nil {
+		t.Fatal("Sample larger than the corpus should fail")
+	}
+
+	if _, err := corpus.Sample(-1, 1); err == nil {
+		t.Fatal("Negative sample size should fail")
+	}
+
+	withReplacement, err := corpus.SampleWithReplacement(20, 1)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(withReplacement) != 20 {
+		t.Fatal("Unexpected sample size:", len(withReplacement))
+	}
+
+	if again, _ := corpus.SampleWithReplacement(20, 1); !reflect.DeepEqual(withReplacement, again) {
+		t.Fatal("Samples with the same seed should be equal")
+	}
+
+	if empty, _ := Corpus(nil).SampleWithReplacement(3, 1); empty != nil {
+		t.Fatal("Sample of an empty corpus should be empty")
+	}
+
+	if _, err := corpus.SampleWithReplacement(-1, 1); err == nil {
+		t.Fatal("Negative sample size should fail")
+	}
+}
+
+func batchLengths(t *testing.T, corpus Corpus, batchSize, bucketWidth int) [][]int {
+	batches, err := corpus.Batches(batchSize, bucketWidth)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	var lengths [][]int
+	for _, batch := range batches {
+		lengths = append(lengths, corpusLengths(batch))
+	}
+
+	return lengths
+}
+
+func TestBatches(t *testing.T) {
+	corpus := lengthCorpus(1, 5, 2, 6, 3, 9)
+
+	if lengths := batchLengths(t, corpus, 2, 0); !reflect.DeepEqual(lengths, [][]int{{1, 5}, {2, 6}, {3, 9}}) {
+		t.Fatal("Unexpected batches:", lengths)
+	}
+
+	if lengths := batchLengths(t, corpus, 2, 4); !reflect.DeepEqual(lengths, [][]int{{1, 2}, {5, 6}, {3}, {9}}) {
+		t.Fatal("Unexpected bucketed batches:", lengths)
+	}
+
+	if _, err := corpus.Batches(0, 0); err == nil {
+		t.Fatal("Batch size 0 should fail")
+	}
+}
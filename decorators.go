@@ -0,0 +1,196 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conllx
+
+import "io"
+
+var _ SentenceReader = &FilterReader{}
+
+// FilterReader is a wrapper around a SentenceReader that only returns
+// the sentences for which a predicate holds.
+type FilterReader struct {
+	reader    SentenceReader
+	predicate func(Sentence) bool
+}
+
+// NewFilterReader creates a FilterReader that returns the sentences of
+// 'reader' for which 'predicate' returns true.
+func NewFilterReader(reader SentenceReader, predicate func(Sentence) bool) *FilterReader {
+	return &FilterReader{
+		reader:    reader,
+		predicate: predicate,
+	}
+}
+
+// ReadSentence returns the next sentence for which the predicate holds.
+func (r *FilterReader) ReadSentence() (sentence Sentence, err error) {
+	for {
+		sentence, err := r.reader.ReadSentence()
+		if err != nil {
+			return sentence, err
+		}
+
+		if r.predicate(sentence) {
+			return sentence, nil
+		}
+	}
+}
+
+var _ SentenceReader = &MapReader{}
+
+// MapReader is a wrapper around a SentenceReader that transforms every
+// sentence that is read.
+type MapReader struct {
+	reader SentenceReader
+	f      func(Sentence) (Sentence, error)
+}
+
+// NewMapReader creates a MapReader that applies 'f' to every sentence of
+// 'reader'. An error that is returned by 'f' is returned by ReadSentence.
+//
+// The sentence that is passed to 'f' has the same lifetime as the
+// sentences returned by the wrapped reader, so 'f' may modify it in place.
+func NewMapReader(reader SentenceReader, f func(Sentence) (Sentence, error)) *MapReader {
+	return &MapReader{
+		reader: reader,
+		f:      f,
+	}
+}
+
+// ReadSentence returns the next transformed sentence.
+func (r *MapReader) ReadSentence() (sentence Sentence, err error) {
+	sentence, err = r.reader.ReadSentence()
+	if err != nil {
+		return sentence, err
+	}
+
+	return r.f(sentence)
+}
+
+var _ SentenceReader = &LimitReader{}
+
+// LimitReader is a wrapper around a SentenceReader that returns at most a
+// fixed number of sentences.
+type LimitReader struct {
+	reader SentenceReader
+	n      int
+}
+
+// NewLimitReader creates a LimitReader that returns the first 'n'
+// sentences of 'reader'.
+func NewLimitReader(reader SentenceReader, n int) *LimitReader {
+	return &LimitReader{
+		reader: reader,
+		n:      n,
+	}
+}
+
+// ReadSentence returns the next sentence, or io.EOF when the limit is
+// reached. Errors of the underlying reader do not count towards the
+// limit.
+func (r *LimitReader) ReadSentence() (sentence Sentence, err error) {
+	if r.n <= 0 {
+		return nil, io.EOF
+	}
+
+	sentence, err = r.reader.ReadSentence()
+	if err != nil {
+		return nil, err
+	}
+
+	r.n--
+
+	return sentence, nil
+}
+
+var _ SentenceReader = &SkipReader{}
+
+// SkipReader is a wrapper around a SentenceReader that skips a fixed
+// number of sentences.
+type SkipReader struct {
+	reader SentenceReader
+	n      int
+}
+
+// NewSkipReader creates a SkipReader that returns the sentences of
+// 'reader', except for the first 'n' sentences.
+func NewSkipReader(reader SentenceReader, n int) *SkipReader {
+	return &SkipReader{
+		reader: reader,
+		n:      n,
+	}
+}
+
+// ReadSentence returns the next sentence after the skipped sentences.
+func (r *SkipReader) ReadSentence() (sentence Sentence, err error) {
+	for ; r.n > 0; r.n-- {
+		if _, err := r.reader.ReadSentence(); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.reader.ReadSentence()
+}
+
+var _ SentenceReader = &ConcatReader{}
+
+// ConcatReader reads the sentences of multiple readers in succession.
+type ConcatReader struct {
+	readers []SentenceReader
+}
+
+// NewConcatReader creates a ConcatReader that returns the sentences of
+// each of the provided readers, one reader after the other.
+func NewConcatReader(readers ...SentenceReader) *ConcatReader {
+	return &ConcatReader{
+		readers: readers,
+	}
+}
+
+// ReadSentence returns the next sentence, or io.EOF when all readers are
+// exhausted.
+func (r *ConcatReader) ReadSentence() (sentence Sentence, err error) {
+	for len(r.readers) != 0 {
+		sentence, err := r.readers[0].ReadSentence()
+		if err == io.EOF {
+			r.readers = r.readers[1:]
+			continue
+		}
+
+		return sentence, err
+	}
+
+	return nil, io.EOF
+}
+
+var _ SentenceReader = &SliceReader{}
+
+// SliceReader reads sentences from a slice of sentences. This can be
+// used to feed an in-memory corpus to functions that consume a
+// SentenceReader.
+type SliceReader struct {
+	sentences []Sentence
+}
+
+// NewSliceReader creates a SliceReader for the given sentences. The
+// sentences are returned as-is, they are not copied.
+func NewSliceReader(sentences []Sentence) *SliceReader {
+	return &SliceReader{
+		sentences: sentences,
+	}
+}
+
+// ReadSentence returns the next sentence of the slice, or io.EOF when
+// all sentences were returned.
+func (r *SliceReader) ReadSentence() (sentence Sentence, err error) {
+	if len(r.sentences) == 0 {
+		return nil, io.EOF
+	}
+
+	sentence = r.sentences[0]
+	r.sentences = r.sentences[1:]
+
+	return sentence, nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conllx
+
+import (
+	"errors"
+	"io"
+	"testing"
+)
+
+var splitTestSent1 = []Token{
+	*NewToken().SetForm("c"),
+	*NewToken().SetForm("d"),
+}
+
+func readerTestHelper(t *testing.T, r SentenceReader, expected [][]Token) {
+	for _, correct := range expected {
+		sent, err := r.ReadSentence()
+		equalOrFail(t, err, correct, sent)
+	}
+
+	if _, err := r.ReadSentence(); err != io.EOF {
+		t.Fatalf("Reader should return EOF.")
+	}
+}
+
+func firstForm(sentence Sentence) string {
+	form, _ := sentence[0].Form()
+	return form
+}
+
+func TestFilterReader(t *testing.T) {
+	r := NewFilterReader(stringReader(splitTestFragment), func(s Sentence) bool {
+		return firstForm(s) != "c" && firstForm(s) != "i"
+	})
+
+	readerTestHelper(t, r, [][]Token{splitTestSent0, splitTestSent2, splitTestSent3})
+}
+
+func TestMapReader(t *testing.T) {
+	r := NewMapReader(stringReader(longShortFragment), func(s Sentence) (Sentence, error) {
+		return s[:1], nil
+	})
+
+	readerTestHelper(t, r, [][]Token{longShortSentence1[:1], longShortSentence2[:1]})
+
+	mapErr := errors.New("map error")
+	r = NewMapReader(stringReader(longShortFragment), func(s Sentence) (Sentence, error) {
+		return nil, mapErr
+	})
+
+	if _, err := r.ReadSentence(); err != mapErr {
+		t.Fatal("Expected the error of the map function, got:", err)
+	}
+}
+
+func TestLimitSkipReader(t *testing.T) {
+	r := NewLimitReader(NewSkipReader(stringReader(splitTestFragment), 1), 2)
+	readerTestHelper(t, r, [][]Token{splitTestSent1, splitTestSent2})
+
+	readerTestHelper(t, NewSkipReader(stringReader(splitTestFragment), 10), nil)
+	readerTestHelper(t, NewLimitReader(stringReader(splitTestFragment), 0), nil)
+}
+
+func TestLimitReaderError(t *testing.T) {
+	readErr := errors.New("read error")
+	failFirst := true
+	inner := NewMapReader(NewSliceReader([]Sentence{splitTestSent0, splitTestSent1, splitTestSent2}),
+		func(s Sentence) (Sentence, error) {
+			if failFirst {
+				failFirst = false
+				return nil, readErr
+			}
+			return s, nil
+		})
+
+	r := NewLimitReader(inner, 2)
+	if _, err := r.ReadSentence(); err != readErr {
+		t.Fatal("Expected the error of the underlying reader, got:", err)
+	}
+
+	readerTestHelper(t, r, [][]Token{splitTestSent1, splitTestSent2})
+}
+
+func TestConcatReader(t *testing.T) {
+	r := NewConcatReader(
+		stringReader(longShortFragment),
+		stringReader(""),
+		NewLimitReader(stringReader(splitTestFragment), 1))
+
+	readerTestHelper(t, r, [][]Token{longShortSentence1, longShortSentence2, splitTestSent0})
+	readerTestHelper(t, NewConcatReader(), nil)
+}
+
+func TestSliceReader(t *testing.T) {
+	r := NewSliceReader([]Sentence{splitTestSent0, splitTestSent1})
+	readerTestHelper(t, r, [][]Token{splitTestSent0, splitTestSent1})
+}
+
+func TestSplittingComposed(t *testing.T) {
+	filtered := NewFilterReader(stringReader(splitTestFragment), func(s Sentence) bool {
+		return firstForm(s) != "a"
+	})
+
+	r, err := NewSplittingReader(filtered, 2, FoldSet{1: nil})
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	readerTestHelper(t, r, [][]Token{splitTestSent2, splitTestSent4})
+}
@@ -0,0 +1,203 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package filter provides composable predicates over sentences, for
+// instance to remove overly long sentences, duplicates, or sentences
+// that only consist of punctuation.
+package filter
+
+import (
+	"hash/fnv"
+	"regexp"
+	"unicode"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+// A Predicate decides whether a sentence is kept.
+type Predicate func(sentence conllx.Sentence) bool
+
+// And returns a predicate that is true when all predicates are true.
+// And without predicates is always true.
+func And(predicates ...Predicate) Predicate {
+	return func(sentence conllx.Sentence) bool {
+		for _, p := range predicates {
+			if !p(sentence) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// Or returns a predicate that is true when any of the predicates is
+// true. Or without predicates is always false.
+func Or(predicates ...Predicate) Predicate {
+	return func(sentence conllx.Sentence) bool {
+		for _, p := range predicates {
+			if p(sentence) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// Not returns the negation of a predicate.
+func Not(predicate Predicate) Predicate {
+	return func(sentence conllx.Sentence) bool {
+		return !predicate(sentence)
+	}
+}
+
+// MinLength is true for sentences of at least 'n' tokens.
+func MinLength(n int) Predicate {
+	return func(sentence conllx.Sentence) bool {
+		return len(sentence) >= n
+	}
+}
+
+// MaxLength is true for sentences of at most 'n' tokens.
+func MaxLength(n int) Predicate {
+	return func(sentence conllx.Sentence) bool {
+		return len(sentence) <= n
+	}
+}
+
+// HasLayers is true for sentences of which every token has all the
+// given layers.
+func HasLayers(layers ...conllx.Layer) Predicate {
+	return func(sentence conllx.Sentence) bool {
+		for idx := range sentence {
+			for _, layer := range layers {
+				if _, ok := sentence[idx].Layer(layer); !ok {
+					return false
+				}
+			}
+		}
+
+		return true
+	}
+}
+
+// IsTree is true for sentences that form a valid dependency tree (see
+// conllx.Sentence.ValidateTree).
+func IsTree() Predicate {
+	return func(sentence conllx.Sentence) bool {
+		return sentence.ValidateTree() == nil
+	}
+}
+
+// IsProjective is true for sentences that form a projective dependency
+// tree.
+func IsProjective() Predicate {
+	return func(sentence conllx.Sentence) bool {
+		return sentence.IsProjective()
+	}
+}
+
+// AnyFormMatches is true for sentences that have a token of which the
+// form matches 're'.
+func AnyFormMatches(re *regexp.Regexp) Predicate {
+	return func(sentence conllx.Sentence) bool {
+		for idx := range sentence {
+			if form, ok := sentence[idx].Form(); ok && re.MatchString(form) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// AllFormsMatch is true for sentences of which the forms of all tokens
+// match 're'.
+func AllFormsMatch(re *regexp.Regexp) Predicate {
+	return func(sentence conllx.Sentence) bool {
+		for idx := range sentence {
+			if form, ok := sentence[idx].Form(); !ok || !re.MatchString(form) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// PunctuationOnly is true for sentences of which all forms consist of
+// punctuation and symbol characters only, such as "..." or "-- !".
+func PunctuationOnly() Predicate {
+	return func(sentence conllx.Sentence) bool {
+		for idx := range sentence {
+			form, _ := sentence[idx].Form()
+			if form == "" {
+				return false
+			}
+
+			for _, r := range form {
+				if !unicode.IsPunct(r) && !unicode.IsSymbol(r) {
+					return false
+				}
+			}
+		}
+
+		return true
+	}
+}
+
+// A Deduplicator detects sentences with a form sequence that was seen
+// before. Only hashes of form sequences are stored, so that large
+// corpora can be deduplicated. Distinct sentences with the same 64-bit
+// hash are (very rarely) considered duplicates.
+type Deduplicator struct {
+	seen map[uint64]struct{}
+}
+
+// NewDeduplicator creates a deduplicator that has not seen any
+// sentences.
+func NewDeduplicator() *Deduplicator {
+	return &Deduplicator{
+		seen: make(map[uint64]struct{}),
+	}
+}
+
+// Unique returns a predicate that is true for the first occurrence of a
+// form sequence. The predicate records every sentence that it is
+// applied to, so it should be the last predicate in a conjunction.
+func (d *Deduplicator) Unique() Predicate {
+	return func(sentence conllx.Sentence) bool {
+		h := formsHash(sentence)
+		if _, ok := d.seen[h]; ok {
+			return false
+		}
+
+		d.seen[h] = struct{}{}
+
+		return true
+	}
+}
+
+// Len returns the number of distinct form sequences that were seen.
+func (d *Deduplicator) Len() int {
+	return len(d.seen)
+}
+
+func formsHash(sentence conllx.Sentence) uint64 {
+	h := fnv.New64a()
+	for idx := range sentence {
+		form, _ := sentence[idx].Form()
+		h.Write([]byte(form))
+		h.Write([]byte{0})
+	}
+
+	return h.Sum64()
+}
+
+// NewReader returns a reader that only returns the sentences of
+// 'reader' for which 'predicate' is true.
+func NewReader(reader conllx.SentenceReader, predicate Predicate) *conllx.FilterReader {
+	return conllx.NewFilterReader(reader, predicate)
+}
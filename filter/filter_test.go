@@ -0,0 +1,106 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package filter
+
+import (
+	"io"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+func sentence(forms string) conllx.Sentence {
+	var s conllx.Sentence
+	for idx, form := range strings.Fields(forms) {
+		s = append(s, *conllx.NewToken().SetForm(form).SetHead(uint(idx)))
+	}
+
+	return s
+}
+
+func kept(t *testing.T, predicate Predicate, sentences ...conllx.Sentence) []string {
+	reader := NewReader(conllx.NewSliceReader(sentences), predicate)
+
+	var result []string
+	for {
+		s, err := reader.ReadSentence()
+		if err == io.EOF {
+			return result
+		}
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		var forms []string
+		for idx := range s {
+			form, _ := s[idx].Form()
+			forms = append(forms, form)
+		}
+		result = append(result, strings.Join(forms, " "))
+	}
+}
+
+var testSentences = []conllx.Sentence{
+	sentence("Gilles Deleuze"),
+	sentence("..."),
+	sentence("Gilles Deleuze"),
+	sentence("Die Großaufnahme ist ein Affekt-Bild ."),
+	sentence("-- !"),
+}
+
+func TestPredicates(t *testing.T) {
+	for _, test := range []struct {
+		predicate Predicate
+		expected  []string
+	}{
+		{MinLength(2), []string{"Gilles Deleuze", "Gilles Deleuze", "Die Großaufnahme ist ein Affekt-Bild .", "-- !"}},
+		{MaxLength(1), []string{"..."}},
+		{PunctuationOnly(), []string{"...", "-- !"}},
+		{AnyFormMatches(regexp.MustCompile(`-`)), []string{"Die Großaufnahme ist ein Affekt-Bild .", "-- !"}},
+		{AllFormsMatch(regexp.MustCompile(`^\p{Lu}`)), []string{"Gilles Deleuze", "Gilles Deleuze"}},
+		{HasLayers(conllx.FormLayer, conllx.PosTagLayer), nil},
+		{
+			And(Not(PunctuationOnly()), MaxLength(3), NewDeduplicator().Unique()),
+			[]string{"Gilles Deleuze"},
+		},
+		{Or(MaxLength(1), MinLength(5)), []string{"...", "Die Großaufnahme ist ein Affekt-Bild ."}},
+		{And(), []string{"Gilles Deleuze", "...", "Gilles Deleuze", "Die Großaufnahme ist ein Affekt-Bild .", "-- !"}},
+		{Or(), nil},
+	} {
+		if result := kept(t, test.predicate, testSentences...); !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Expected %q, got %q", test.expected, result)
+		}
+	}
+}
+
+func TestIsTree(t *testing.T) {
+	cyclic := conllx.Sentence{
+		*conllx.NewToken().SetForm("a").SetHead(2),
+		*conllx.NewToken().SetForm("b").SetHead(1),
+	}
+	noHeads := conllx.Sentence{*conllx.NewToken().SetForm("c")}
+
+	if result := kept(t, IsTree(), sentence("Gilles Deleuze"), cyclic, noHeads); !reflect.DeepEqual(result, []string{"Gilles Deleuze"}) {
+		t.Fatal("Unexpected trees:", result)
+	}
+}
+
+func TestDeduplicator(t *testing.T) {
+	dedup := NewDeduplicator()
+	unique := dedup.Unique()
+
+	result := kept(t, unique, testSentences...)
+	if len(result) != 4 || dedup.Len() != 4 {
+		t.Fatalf("Expected 4 unique sentences, got %d (%d seen)", len(result), dedup.Len())
+	}
+
+	// Tokens are separated in the hash.
+	if !unique(sentence("Gilles Deleuz e")) {
+		t.Fatal("Sentences with different tokenization should be distinct")
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conllx
+
+import (
+	"hash/fnv"
+	"math/rand"
+	"strconv"
+	"strings"
+)
+
+// A FoldAssigner assigns sentences to folds. Assigners may be stateful,
+// so a fresh assigner should be used for each pass over a corpus.
+type FoldAssigner interface {
+	// AssignFold returns the fold of the next sentence of the corpus,
+	// which is in the range [0, nFolds).
+	AssignFold(sentence Sentence, nFolds int) int
+}
+
+// A SentenceKey extracts a key from a sentence, such as a sentence
+// identifier, genre, or length bucket.
+type SentenceKey func(Sentence) string
+
+// FormsKey is a SentenceKey that returns the forms of a sentence,
+// separated by spaces.
+func FormsKey(sentence Sentence) string {
+	forms := make([]string, len(sentence))
+	for idx := range sentence {
+		forms[idx], _ = sentence[idx].Form()
+	}
+
+	return strings.Join(forms, " ")
+}
+
+// DefaultLengthBucketWidth is the bucket width of the length strata
+// that are used when a stratified assigner is created without a key.
+const DefaultLengthBucketWidth = 10
+
+// LengthBucketKey returns a SentenceKey that puts sentences in buckets
+// by their length. Each bucket spans 'width' sentence lengths. A width
+// smaller than 1 is treated as 1.
+func LengthBucketKey(width int) SentenceKey {
+	if width < 1 {
+		width = 1
+	}
+
+	return func(sentence Sentence) string {
+		return strconv.Itoa(len(sentence) / width)
+	}
+}
+
+type roundRobinAssigner struct {
+	next int
+}
+
+// NewRoundRobinAssigner creates an assigner that assigns sentences to
+// folds by their position in the corpus: sentence i is assigned to
+// fold i mod nFolds. This is the default assigner of SplittingReader.
+func NewRoundRobinAssigner() FoldAssigner {
+	return &roundRobinAssigner{}
+}
+
+func (a *roundRobinAssigner) AssignFold(sentence Sentence, nFolds int) int {
+	fold := a.next % nFolds
+	a.next = fold + 1
+	return fold
+}
+
+type randomAssigner struct {
+	rng *rand.Rand
+}
+
+// NewRandomAssigner creates an assigner that assigns each sentence to a
+// random fold. The assignment is determined by the seed, so two
+// assigners with the same seed give the same folds for a corpus.
+func NewRandomAssigner(seed int64) FoldAssigner {
+	return &randomAssigner{
+		rng: rand.New(rand.NewSource(seed)),
+	}
+}
+
+func (a *randomAssigner) AssignFold(sentence Sentence, nFolds int) int {
+	return a.rng.Intn(nFolds)
+}
+
+type hashAssigner struct {
+	key SentenceKey
+}
+
+// NewHashAssigner creates an assigner that assigns sentences to folds
+// by a hash of their key. Since the fold of a sentence only depends on
+// its key, assignments are stable when other sentences are added to or
+// removed from the corpus. If 'key' is nil, FormsKey is used.
+func NewHashAssigner(key SentenceKey) FoldAssigner {
+	if key == nil {
+		key = FormsKey
+	}
+
+	return &hashAssigner{
+		key: key,
+	}
+}
+
+func (a *hashAssigner) AssignFold(sentence Sentence, nFolds int) int {
+	h := fnv.New32a()
+	h.Write([]byte(a.key(sentence)))
+	return int(h.Sum32() % uint32(nFolds))
+}
+
+type stratifiedAssigner struct {
+	key  SentenceKey
+	rng  *rand.Rand
+	next map[string]int
+}
+
+// NewStratifiedAssigner creates an assigner that distributes the
+// sentences of each stratum evenly over the folds. Strata are
+// determined by 'key', for instance the genre of a sentence or
+// LengthBucketKey. Within a stratum, folds are assigned round-robin,
+// starting at a fold that is chosen randomly using the seed. If 'key'
+// is nil, sentences are stratified by length in buckets of
+// DefaultLengthBucketWidth.
+func NewStratifiedAssigner(key SentenceKey, seed int64) FoldAssigner {
+	if key == nil {
+		key = LengthBucketKey(DefaultLengthBucketWidth)
+	}
+
+	return &stratifiedAssigner{
+		key:  key,
+		rng:  rand.New(rand.NewSource(seed)),
+		next: make(map[string]int),
+	}
+}
+
+func (a *stratifiedAssigner) AssignFold(sentence Sentence, nFolds int) int {
+	stratum := a.key(sentence)
+
+	next, ok := a.next[stratum]
+	if !ok {
+		next = a.rng.Intn(nFolds)
+	}
+
+	fold := next % nFolds
+	a.next[stratum] = fold + 1
+
+	return fold
+}
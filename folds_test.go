@@ -0,0 +1,137 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conllx
+
+import (
+	"reflect"
+	"testing"
+)
+
+var foldTestSentences = []Sentence{
+	splitTestSent0,
+	splitTestSent1,
+	splitTestSent2,
+	splitTestSent3,
+	splitTestSent4,
+	{*NewToken().SetForm("k")},
+	{*NewToken().SetForm("l")},
+	{*NewToken().SetForm("m")},
+}
+
+func assignFolds(assigner FoldAssigner, sentences []Sentence, nFolds int) []int {
+	folds := make([]int, len(sentences))
+	for idx, sentence := range sentences {
+		folds[idx] = assigner.AssignFold(sentence, nFolds)
+	}
+
+	return folds
+}
+
+func checkFoldRange(t *testing.T, folds []int, nFolds int) {
+	for _, fold := range folds {
+		if fold < 0 || fold >= nFolds {
+			t.Fatalf("Fold %d is not in [0, %d)", fold, nFolds)
+		}
+	}
+}
+
+func TestRoundRobinAssigner(t *testing.T) {
+	folds := assignFolds(NewRoundRobinAssigner(), foldTestSentences, 3)
+	if !reflect.DeepEqual(folds, []int{0, 1, 2, 0, 1, 2, 0, 1}) {
+		t.Fatal("Unexpected round-robin folds:", folds)
+	}
+}
+
+func TestRandomAssigner(t *testing.T) {
+	folds := assignFolds(NewRandomAssigner(42), foldTestSentences, 3)
+	checkFoldRange(t, folds, 3)
+
+	if !reflect.DeepEqual(folds, assignFolds(NewRandomAssigner(42), foldTestSentences, 3)) {
+		t.Fatal("Assigners with the same seed should assign the same folds")
+	}
+}
+
+func TestHashAssigner(t *testing.T) {
+	folds := assignFolds(NewHashAssigner(nil), foldTestSentences, 3)
+	checkFoldRange(t, folds, 3)
+
+	// Removing a sentence should not change the folds of the others.
+	edited := append([]Sentence{}, foldTestSentences[1:]...)
+	if !reflect.DeepEqual(folds[1:], assignFolds(NewHashAssigner(nil), edited, 3)) {
+		t.Fatal("Hash-based folds should be stable under corpus edits")
+	}
+}
+
+func TestStratifiedAssigner(t *testing.T) {
+	// Strata: 0 for one-token sentences, 1 for two-token sentences.
+	key := LengthBucketKey(2)
+	folds := assignFolds(NewStratifiedAssigner(key, 7), foldTestSentences, 2)
+	checkFoldRange(t, folds, 2)
+
+	counts := make(map[string][]int)
+	for idx, sentence := range foldTestSentences {
+		stratum := key(sentence)
+		if counts[stratum] == nil {
+			counts[stratum] = make([]int, 2)
+		}
+		counts[stratum][folds[idx]]++
+	}
+
+	for stratum, foldCounts := range counts {
+		if diff := foldCounts[0] - foldCounts[1]; diff < -1 || diff > 1 {
+			t.Fatalf("Stratum %s is not evenly distributed: %v", stratum, foldCounts)
+		}
+	}
+}
+
+func TestStratifiedAssignerNilKey(t *testing.T) {
+	// All test sentences are in the same length stratum, so folds are
+	// assigned round-robin.
+	folds := assignFolds(NewStratifiedAssigner(nil, 7), foldTestSentences, 3)
+	checkFoldRange(t, folds, 3)
+
+	for idx := 1; idx < len(folds); idx++ {
+		if folds[idx] != (folds[idx-1]+1)%3 {
+			t.Fatal("Sentences of one stratum should be assigned round-robin:", folds)
+		}
+	}
+}
+
+func TestLengthBucketKey(t *testing.T) {
+	if key := LengthBucketKey(2)(splitTestSent0); key != "1" {
+		t.Fatalf("Expected bucket 1, got %s", key)
+	}
+
+	for _, width := range []int{0, -1} {
+		if key := LengthBucketKey(width)(splitTestSent0); key != "2" {
+			t.Fatalf("Expected bucket 2 for width %d, got %s", width, key)
+		}
+	}
+}
+
+func TestFormsKey(t *testing.T) {
+	if key := FormsKey(splitTestSent0); key != "a b" {
+		t.Fatalf("Expected key 'a b', got '%s'", key)
+	}
+}
+
+func TestSplittingAssigner(t *testing.T) {
+	for fold := 0; fold < 3; fold++ {
+		r, err := NewSplittingReader(NewSliceReader(foldTestSentences), 3, FoldSet{fold: nil},
+			FoldAssignment(NewRandomAssigner(1)))
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		var expected [][]Token
+		for idx, f := range assignFolds(NewRandomAssigner(1), foldTestSentences, 3) {
+			if f == fold {
+				expected = append(expected, foldTestSentences[idx])
+			}
+		}
+
+		readerTestHelper(t, r, expected)
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conllx
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// IndexSuffix is the suffix of the sidecar files in which LoadIndex
+// stores indexes.
+const IndexSuffix = ".idx"
+
+const indexMagic = "CNLXIDX1"
+
+// An Index stores the byte offsets of the sentences in CoNLL-X data,
+// together with the size and modification time of the indexed file.
+type Index struct {
+	offsets []int64
+	size    int64
+	modTime time.Time
+}
+
+// BuildIndex builds an index of the sentences that can be read from
+// 'r'. The modification time of the index is zero.
+func BuildIndex(r io.Reader) (*Index, error) {
+	reader := bufio.NewReader(r)
+	index := &Index{}
+	inSentence := false
+
+	for {
+		line, err := reader.ReadString('\n')
+		if len(line) != 0 {
+			blank := len(strings.TrimSpace(line)) == 0
+			if !blank && !inSentence {
+				index.offsets = append(index.offsets, index.size)
+			}
+			inSentence = !blank
+
+			index.size += int64(len(line))
+		}
+
+		if err == io.EOF {
+			return index, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// IndexFile builds an index of the sentences in a file.
+func IndexFile(path string) (*Index, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := BuildIndex(f)
+	if err != nil {
+		return nil, err
+	}
+
+	if index.size != info.Size() {
+		return nil, fmt.Errorf("File changed while it was indexed: %s", path)
+	}
+
+	index.modTime = info.ModTime()
+
+	return index, nil
+}
+
+// LoadIndex returns the index of a file. If the sidecar file (the path
+// with IndexSuffix appended) contains an index that matches the size
+// and modification time of the file, that index is returned. Otherwise,
+// the file is indexed and the index is stored in the sidecar file.
+func LoadIndex(path string) (*Index, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	sidecar := path + IndexSuffix
+	if f, err := os.Open(sidecar); err == nil {
+		index, err := ReadIndex(bufio.NewReader(f))
+		f.Close()
+
+		if err == nil && index.Matches(info) {
+			return index, nil
+		}
+	}
+
+	index, err := IndexFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Create(sidecar)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := index.Write(f); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+
+	return index, nil
+}
+
+// Len returns the number of sentences in the index.
+func (i *Index) Len() int {
+	return len(i.offsets)
+}
+
+// Size returns the size of the indexed data in bytes.
+func (i *Index) Size() int64 {
+	return i.size
+}
+
+// Offset returns the byte offset of the sentence with the given
+// number, starting at 0.
+func (i *Index) Offset(n int) int64 {
+	return i.offsets[n]
+}
+
+// Matches returns true if the index has the size and modification time
+// of the given file.
+func (i *Index) Matches(info os.FileInfo) bool {
+	return i.size == info.Size() && i.modTime.Equal(info.ModTime())
+}
+
+// Write writes the index in a binary format.
+func (i *Index) Write(w io.Writer) error {
+	buf := []byte(indexMagic)
+	buf = binary.AppendVarint(buf, i.size)
+	buf = binary.AppendVarint(buf, i.modTime.UnixNano())
+	buf = binary.AppendUvarint(buf, uint64(len(i.offsets)))
+
+	prev := int64(0)
+	for _, offset := range i.offsets {
+		buf = binary.AppendUvarint(buf, uint64(offset-prev))
+		prev = offset
+	}
+
+	_, err := w.Write(buf)
+	return err
+}
+
+// ReadIndex reads an index in the format written by Index.Write.
+func ReadIndex(r io.ByteReader) (*Index, error) {
+	for idx := 0; idx < len(indexMagic); idx++ {
+		if c, err := r.ReadByte(); err != nil || c != indexMagic[idx] {
+			return nil, errors.New("Data is not a sentence index")
+		}
+	}
+
+	size, err := binary.ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+
+	modTime, err := binary.ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+
+	index := &Index{
+		size:    size,
+		modTime: time.Unix(0, modTime),
+	}
+
+	offset := int64(0)
+	for idx := uint64(0); idx < n; idx++ {
+		delta, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, err
+		}
+
+		offset += int64(delta)
+		if offset >= size {
+			return nil, errors.New("Sentence offset beyond the end of the data")
+		}
+
+		index.offsets = append(index.offsets, offset)
+	}
+
+	return index, nil
+}
+
+// An IndexedReader reads sentences by their number, using an index of
+// the sentence offsets.
+type IndexedReader struct {
+	reader io.ReaderAt
+	index  *Index
+}
+
+// NewIndexedReader creates a reader for the indexed CoNLL-X data in
+// 'r', such as an *os.File. The caller is responsible for closing the
+// provided reader.
+func NewIndexedReader(r io.ReaderAt, index *Index) *IndexedReader {
+	return &IndexedReader{
+		reader: r,
+		index:  index,
+	}
+}
+
+// Len returns the number of sentences.
+func (r *IndexedReader) Len() int {
+	return r.index.Len()
+}
+
+// Sentence returns the sentence with the given number, starting at 0.
+func (r *IndexedReader) Sentence(n int) (Sentence, error) {
+	reader, err := r.Range(n, n+1)
+	if err != nil {
+		return nil, err
+	}
+
+	sentence, err := reader.ReadSentence()
+	if err == io.EOF {
+		return nil, fmt.Errorf("Sentence %d is empty, the index may be out of date", n)
+	}
+
+	return sentence, err
+}
+
+// Range returns a reader for the sentences from 'start' up to (but not
+// including) 'end'. The sentences are parsed by Reader.
+func (r *IndexedReader) Range(start, end int) (SentenceReader, error) {
+	if start < 0 || end > r.index.Len() || start > end {
+		return nil, fmt.Errorf("Invalid sentence range: [%d, %d)", start, end)
+	}
+
+	if start == end {
+		return NewSliceReader(nil), nil
+	}
+
+	from := r.index.offsets[start]
+	to := r.index.size
+	if end < r.index.Len() {
+		to = r.index.offsets[end]
+	}
+
+	section := io.NewSectionReader(r.reader, from, to-from)
+
+	return NewReader(bufio.NewReader(section), FreshSentences()), nil
+}
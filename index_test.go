@@ -0,0 +1,124 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conllx
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestIndexedReader(t *testing.T) {
+	data := "\n" + testFragmentRobust + "\n\n" + longShortFragment
+
+	index, err := BuildIndex(strings.NewReader(data))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if index.Len() != 4 || index.Size() != int64(len(data)) {
+		t.Fatalf("Unexpected index: %d sentences, %d bytes", index.Len(), index.Size())
+	}
+
+	reader := NewIndexedReader(strings.NewReader(data), index)
+
+	for _, test := range []struct {
+		n        int
+		expected []Token
+	}{
+		{3, longShortSentence2},
+		{0, testFragmentSent1},
+		{1, testFragmentSent2},
+		{2, longShortSentence1},
+	} {
+		sentence, err := reader.Sentence(test.n)
+		equalOrFail(t, err, test.expected, sentence)
+	}
+
+	rangeReader, err := reader.Range(1, 3)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	readerTestHelper(t, rangeReader, [][]Token{testFragmentSent2, longShortSentence1})
+
+	for _, r := range [][2]int{{-1, 1}, {2, 1}, {0, 5}} {
+		if _, err := reader.Range(r[0], r[1]); err == nil {
+			t.Errorf("Range %v should be rejected", r)
+		}
+	}
+}
+
+func TestIndexReadWrite(t *testing.T) {
+	index, err := BuildIndex(strings.NewReader(testFragment))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	var buf bytes.Buffer
+	if err := index.Write(&buf); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	read, err := ReadIndex(bufio.NewReader(&buf))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if !reflect.DeepEqual(read.offsets, index.offsets) || read.size != index.size {
+		t.Fatalf("Expected %v, got %v", index, read)
+	}
+
+	if _, err := ReadIndex(bufio.NewReader(strings.NewReader("CNLXIDX0"))); err == nil {
+		t.Fatal("Data with the wrong magic should not be read")
+	}
+}
+
+func TestLoadIndex(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "corpus.conll")
+	if err := os.WriteFile(path, []byte(testFragment), 0644); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	index, err := LoadIndex(path)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if _, err := os.Stat(path + IndexSuffix); err != nil {
+		t.Fatal("Sidecar file should be written:", err)
+	}
+
+	cached, err := LoadIndex(path)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if !reflect.DeepEqual(cached.offsets, index.offsets) {
+		t.Fatalf("Expected %v, got %v", index.offsets, cached.offsets)
+	}
+
+	// A changed file must be reindexed.
+	if err := os.WriteFile(path, []byte(longShortFragment+"\n"+testFragment), 0644); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	index, err = LoadIndex(path)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	defer f.Close()
+
+	sentence, err := NewIndexedReader(f, index).Sentence(3)
+	equalOrFail(t, err, testFragmentSent2, sentence)
+}
@@ -0,0 +1,137 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conllx
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// An Annotator is used for jackknifing. It should train a model on the
+// sentences of 'train' and write the sentences of 'test', annotated by
+// that model, to 'annotated'. Annotated sentences must be written in
+// the order in which they are read from 'test'.
+type Annotator func(fold int, train, test SentenceReader, annotated SentenceWriter) error
+
+// Jackknife annotates a corpus using jackknifing: the corpus is split
+// in 'nFolds' folds and the sentences of each fold are annotated by an
+// annotator that is trained on the other folds. The annotated sentences
+// are written to 'writer' in the original corpus order. If 'assigner' is
+// nil, sentences are assigned to folds round-robin.
+//
+// Jackknifing is typically used to produce training data with predicted
+// rather than gold-standard annotations, such as part-of-speech tags.
+// The corpus is read into memory.
+func Jackknife(reader SentenceReader, nFolds int, assigner FoldAssigner, annotator Annotator, writer SentenceWriter) error {
+	if nFolds < 2 {
+		return errors.New("Jackknifing requires at least 2 folds.")
+	}
+
+	if assigner == nil {
+		assigner = NewRoundRobinAssigner()
+	}
+
+	var corpus []Sentence
+	var folds []int
+	for {
+		sentence, err := reader.ReadSentence()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		corpus = append(corpus, sentence.Clone())
+		folds = append(folds, assigner.AssignFold(sentence, nFolds))
+	}
+
+	annotated := make([]Sentence, len(corpus))
+
+	for fold := 0; fold < nFolds; fold++ {
+		trainFolds := FoldSet{}
+		for idx := 0; idx < nFolds; idx++ {
+			if idx != fold {
+				trainFolds[idx] = nil
+			}
+		}
+
+		split, err := NewSplittingReader(NewSliceReader(corpus), nFolds, trainFolds,
+			FoldAssignment(&fixedAssigner{folds: folds}))
+		if err != nil {
+			return err
+		}
+
+		// The annotator gets clones of the training and test sentences,
+		// so that it cannot modify the data of other folds.
+		train := NewMapReader(split, cloneSentence)
+
+		var test []Sentence
+		var testIndices []int
+		for idx, sentenceFold := range folds {
+			if sentenceFold == fold {
+				test = append(test, corpus[idx].Clone())
+				testIndices = append(testIndices, idx)
+			}
+		}
+
+		collector := &indexedWriter{
+			sentences: annotated,
+			indices:   testIndices,
+		}
+
+		if err := annotator(fold, train, NewSliceReader(test), collector); err != nil {
+			return err
+		}
+
+		if len(collector.indices) != 0 {
+			return fmt.Errorf("Annotator wrote %d sentences for fold %d, expected %d",
+				len(testIndices)-len(collector.indices), fold, len(testIndices))
+		}
+	}
+
+	for _, sentence := range annotated {
+		if err := writer.WriteSentence(sentence); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func cloneSentence(sentence Sentence) (Sentence, error) {
+	return sentence.Clone(), nil
+}
+
+// fixedAssigner replays a previously-computed fold assignment.
+type fixedAssigner struct {
+	folds []int
+	next  int
+}
+
+func (a *fixedAssigner) AssignFold(sentence Sentence, nFolds int) int {
+	fold := a.folds[a.next]
+	a.next++
+	return fold
+}
+
+// indexedWriter stores clones of written sentences at the given
+// indices of a slice.
+type indexedWriter struct {
+	sentences []Sentence
+	indices   []int
+}
+
+func (w *indexedWriter) WriteSentence(sentence Sentence) error {
+	if len(w.indices) == 0 {
+		return errors.New("Annotator wrote more sentences than it read.")
+	}
+
+	w.sentences[w.indices[0]] = sentence.Clone()
+	w.indices = w.indices[1:]
+
+	return nil
+}
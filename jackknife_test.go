@@ -0,0 +1,124 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conllx
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"testing"
+)
+
+func TestJackknife(t *testing.T) {
+	annotator := func(fold int, train, test SentenceReader, annotated SentenceWriter) error {
+		trainForms := make(map[string]bool)
+		for sentence, err := range Sentences(context.Background(), train) {
+			if err != nil {
+				return err
+			}
+			trainForms[FormsKey(sentence)] = true
+		}
+
+		for sentence, err := range Sentences(context.Background(), test) {
+			if err != nil {
+				return err
+			}
+
+			if trainForms[FormsKey(sentence)] {
+				t.Fatalf("Sentence '%s' is in the train and test data of fold %d", FormsKey(sentence), fold)
+			}
+
+			for idx := range sentence {
+				sentence[idx].SetPosTag(strconv.Itoa(fold))
+			}
+
+			if err := annotated.WriteSentence(sentence); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	w := &sliceWriter{}
+	if err := Jackknife(NewSliceReader(foldTestSentences), 3, nil, annotator, w); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(w.sentences) != len(foldTestSentences) {
+		t.Fatalf("Expected %d sentences, got %d", len(foldTestSentences), len(w.sentences))
+	}
+
+	for idx, sentence := range w.sentences {
+		if FormsKey(sentence) != FormsKey(foldTestSentences[idx]) {
+			t.Fatalf("Sentence %d is out of order: %s", idx, FormsKey(sentence))
+		}
+
+		if tag, _ := sentence[0].PosTag(); tag != strconv.Itoa(idx%3) {
+			t.Fatalf("Sentence %d should be annotated in fold %d, was annotated in fold %s", idx, idx%3, tag)
+		}
+	}
+
+	// The original sentences should not be modified.
+	if _, ok := foldTestSentences[0][0].PosTag(); ok {
+		t.Fatal("Jackknifing modified the input sentences")
+	}
+}
+
+func TestJackknifeModifyingAnnotator(t *testing.T) {
+	annotator := func(fold int, train, test SentenceReader, annotated SentenceWriter) error {
+		// Read directly, Sentences returns clones.
+		for {
+			sentence, err := train.ReadSentence()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+
+			for idx := range sentence {
+				sentence[idx].SetForm("train").SetPosTag("train")
+			}
+		}
+
+		for sentence, err := range Sentences(context.Background(), test) {
+			if err != nil {
+				return err
+			}
+
+			if err := annotated.WriteSentence(sentence); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	w := &sliceWriter{}
+	if err := Jackknife(NewSliceReader(foldTestSentences), 3, nil, annotator, w); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	for idx, sentence := range w.sentences {
+		if FormsKey(sentence) != FormsKey(foldTestSentences[idx]) {
+			t.Fatalf("Training data of sentence %d was modified: %s", idx, FormsKey(sentence))
+		}
+
+		if _, ok := sentence[0].PosTag(); ok {
+			t.Fatalf("Training data of sentence %d was modified", idx)
+		}
+	}
+}
+
+func TestJackknifeMissingSentences(t *testing.T) {
+	annotator := func(fold int, train, test SentenceReader, annotated SentenceWriter) error {
+		return nil
+	}
+
+	if err := Jackknife(NewSliceReader(foldTestSentences), 3, nil, annotator, &sliceWriter{}); err == nil {
+		t.Fatal("expected error when the annotator does not write all sentences")
+	}
+}
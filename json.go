@@ -0,0 +1,177 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conllx
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// jsonToken is the JSON representation of a token. Absent layers are
+// nil and omitted, so that they can be distinguished from layers with
+// an empty value.
+type jsonToken struct {
+	Form         *string  `json:"form,omitempty"`
+	Lemma        *string  `json:"lemma,omitempty"`
+	CoarsePosTag *string  `json:"cpostag,omitempty"`
+	PosTag       *string  `json:"postag,omitempty"`
+	Features     *string  `json:"feats,omitempty"`
+	Head         *uint    `json:"head,omitempty"`
+	HeadRel      *string  `json:"deprel,omitempty"`
+	PHead        *uint    `json:"phead,omitempty"`
+	PHeadRel     *string  `json:"pdeprel,omitempty"`
+	Extra        []string `json:"extra,omitempty"`
+}
+
+var _ json.Marshaler = Token{}
+var _ json.Unmarshaler = &Token{}
+
+// MarshalJSON encodes the token as a JSON object with a member per
+// layer that is present in the token. The members are named after the
+// layers (see Layer.String), features are encoded as a string. Extra
+// columns are stored in the extra member.
+func (t Token) MarshalJSON() ([]byte, error) {
+	var jt jsonToken
+
+	jt.Form = stringPtr(t.Form)
+	jt.Lemma = stringPtr(t.Lemma)
+	jt.CoarsePosTag = stringPtr(t.CoarsePosTag)
+	jt.PosTag = stringPtr(t.PosTag)
+	jt.HeadRel = stringPtr(t.HeadRel)
+	jt.PHeadRel = stringPtr(t.PHeadRel)
+	jt.Head = uintPtr(t.Head)
+	jt.PHead = uintPtr(t.PHead)
+	jt.Extra = t.extra
+
+	if features, ok := t.Features(); ok {
+		featuresString := features.FeaturesString()
+		jt.Features = &featuresString
+	}
+
+	return json.Marshal(jt)
+}
+
+// UnmarshalJSON decodes a token from the representation produced by
+// MarshalJSON. Layers that are missing from the JSON object are absent
+// in the token.
+func (t *Token) UnmarshalJSON(data []byte) error {
+	var jt jsonToken
+	if err := json.Unmarshal(data, &jt); err != nil {
+		return err
+	}
+
+	*t = Token{}
+
+	if jt.Form != nil {
+		t.SetForm(*jt.Form)
+	}
+	if jt.Lemma != nil {
+		t.SetLemma(*jt.Lemma)
+	}
+	if jt.CoarsePosTag != nil {
+		t.SetCoarsePosTag(*jt.CoarsePosTag)
+	}
+	if jt.PosTag != nil {
+		t.SetPosTag(*jt.PosTag)
+	}
+	if jt.Features != nil {
+		t.features = NewFeatures(*jt.Features)
+		t.available |= featuresBit
+	}
+	if jt.Head != nil {
+		t.SetHead(*jt.Head)
+	}
+	if jt.HeadRel != nil {
+		t.SetHeadRel(*jt.HeadRel)
+	}
+	if jt.PHead != nil {
+		t.SetPHead(*jt.PHead)
+	}
+	if jt.PHeadRel != nil {
+		t.SetPHeadRel(*jt.PHeadRel)
+	}
+	if len(jt.Extra) != 0 {
+		t.extra = jt.Extra
+	}
+
+	return nil
+}
+
+func stringPtr(f func() (string, bool)) *string {
+	if v, ok := f(); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func uintPtr(f func() (uint, bool)) *uint {
+	if v, ok := f(); ok {
+		return &v
+	}
+
+	return nil
+}
+
+var _ SentenceReader = &JSONLinesReader{}
+
+// A JSONLinesReader reads sentences in the JSON Lines format, where
+// every line is a JSON array of tokens (see Token.MarshalJSON).
+type JSONLinesReader struct {
+	decoder *json.Decoder
+}
+
+// NewJSONLinesReader creates a new JSON Lines reader. The caller is
+// responsible for closing the provided reader.
+func NewJSONLinesReader(r io.Reader) *JSONLinesReader {
+	return &JSONLinesReader{
+		decoder: json.NewDecoder(r),
+	}
+}
+
+// ReadSentence returns the next sentence. If there is no more data
+// that can be read, io.EOF is returned as the error. Values that are
+// not arrays, including null, result in an error.
+func (r *JSONLinesReader) ReadSentence() (Sentence, error) {
+	var sentence Sentence
+	if err := r.decoder.Decode(&sentence); err != nil {
+		return nil, err
+	}
+
+	// null is decoded as a nil slice.
+	if sentence == nil {
+		return nil, errors.New("Expected an array of tokens, got null")
+	}
+
+	return sentence, nil
+}
+
+var _ SentenceWriter = &JSONLinesWriter{}
+
+// A JSONLinesWriter writes sentences in the JSON Lines format, where
+// every line is a JSON array of tokens (see Token.MarshalJSON).
+type JSONLinesWriter struct {
+	encoder *json.Encoder
+}
+
+// NewJSONLinesWriter creates a new JSON Lines writer.
+func NewJSONLinesWriter(w io.Writer) *JSONLinesWriter {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+
+	return &JSONLinesWriter{
+		encoder: encoder,
+	}
+}
+
+// WriteSentence writes a sentence as a single line.
+func (w *JSONLinesWriter) WriteSentence(sentence Sentence) error {
+	if sentence == nil {
+		sentence = Sentence{}
+	}
+
+	return w.encoder.Encode(sentence)
+}
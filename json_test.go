@@ -0,0 +1,83 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conllx
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTokenJSON(t *testing.T) {
+	token := NewToken().SetForm("Gilles").SetLemma("").SetHead(0).SetExtraColumn(0, "0.9")
+
+	data, err := json.Marshal(token)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	expected := `{"form":"Gilles","lemma":"","head":0,"extra":["0.9"]}`
+	if string(data) != expected {
+		t.Fatalf("Expected %s, got %s", expected, data)
+	}
+
+	var decoded Token
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if !reflect.DeepEqual(*token, decoded) {
+		t.Fatalf("Expected %v, got %v", *token, decoded)
+	}
+
+	if _, ok := decoded.PosTag(); ok {
+		t.Fatal("Absent layer should remain absent")
+	}
+
+	if lemma, ok := decoded.Lemma(); !ok || lemma != "" {
+		t.Fatal("Empty lemma should be present")
+	}
+}
+
+func TestJSONLines(t *testing.T) {
+	var buf bytes.Buffer
+	writer := NewJSONLinesWriter(&buf)
+
+	for _, sentence := range []Sentence{testFragmentSent1, testFragmentSent2} {
+		if err := writer.WriteSentence(sentence); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	if lines := strings.Count(buf.String(), "\n"); lines != 2 {
+		t.Fatalf("Expected 2 lines, got %d:\n%s", lines, buf.String())
+	}
+
+	reader := NewJSONLinesReader(&buf)
+	for _, expected := range []Sentence{testFragmentSent1, testFragmentSent2} {
+		sentence, err := reader.ReadSentence()
+		equalOrFail(t, err, expected, sentence)
+	}
+
+	if _, err := reader.ReadSentence(); err != io.EOF {
+		t.Fatal("Reader should return EOF, got:", err)
+	}
+}
+
+func TestJSONLinesErrors(t *testing.T) {
+	for _, data := range []string{
+		`[{"form":1}]`,
+		`[{"head":-1}]`,
+		`{"form":"x"}`,
+		`null`,
+	} {
+		if _, err := NewJSONLinesReader(strings.NewReader(data)).ReadSentence(); err == nil || err == io.EOF {
+			t.Errorf("Expected an error for %s, got: %v", data, err)
+		}
+	}
+}
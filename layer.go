@@ -0,0 +1,118 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conllx
+
+import "fmt"
+
+// A Layer identifies one of the string-valued annotation layers of a
+// token. Layers make it possible to write code that is generic over
+// annotation layers, such as vocabularies or tag mappings.
+type Layer int
+
+const (
+	// FormLayer is the layer of Token.Form.
+	FormLayer Layer = iota
+
+	// LemmaLayer is the layer of Token.Lemma.
+	LemmaLayer
+
+	// CoarsePosTagLayer is the layer of Token.CoarsePosTag.
+	CoarsePosTagLayer
+
+	// PosTagLayer is the layer of Token.PosTag.
+	PosTagLayer
+
+	// FeaturesLayer is the layer of Token.Features, represented as a
+	// features string.
+	FeaturesLayer
+
+	// HeadRelLayer is the layer of Token.HeadRel.
+	HeadRelLayer
+
+	// PHeadRelLayer is the layer of Token.PHeadRel.
+	PHeadRelLayer
+)
+
+var layerNames = []string{
+	FormLayer:         "form",
+	LemmaLayer:        "lemma",
+	CoarsePosTagLayer: "cpostag",
+	PosTagLayer:       "postag",
+	FeaturesLayer:     "feats",
+	HeadRelLayer:      "deprel",
+	PHeadRelLayer:     "pdeprel",
+}
+
+// ParseLayer returns the layer with the given name. The names are those
+// of the CoNLL-X column descriptions: form, lemma, cpostag, postag,
+// feats, deprel, and pdeprel.
+func ParseLayer(name string) (Layer, error) {
+	for layer, layerName := range layerNames {
+		if name == layerName {
+			return Layer(layer), nil
+		}
+	}
+
+	return 0, fmt.Errorf("Unknown layer: %s", name)
+}
+
+func (l Layer) String() string {
+	if l >= 0 && int(l) < len(layerNames) {
+		return layerNames[l]
+	}
+
+	return fmt.Sprintf("Layer(%d)", int(l))
+}
+
+// Layer returns the value of an annotation layer of the token, the
+// second tuple element is false when the layer is absent.
+func (t *Token) Layer(layer Layer) (string, bool) {
+	switch layer {
+	case FormLayer:
+		return t.Form()
+	case LemmaLayer:
+		return t.Lemma()
+	case CoarsePosTagLayer:
+		return t.CoarsePosTag()
+	case PosTagLayer:
+		return t.PosTag()
+	case FeaturesLayer:
+		if features, ok := t.Features(); ok {
+			return features.FeaturesString(), true
+		}
+		return "", false
+	case HeadRelLayer:
+		return t.HeadRel()
+	case PHeadRelLayer:
+		return t.PHeadRel()
+	default:
+		return "", false
+	}
+}
+
+// SetLayer sets the value of an annotation layer of the token. Setting
+// an unknown layer has no effect. The token itself is returned to allow
+// method chaining.
+func (t *Token) SetLayer(layer Layer, value string) *Token {
+	switch layer {
+	case FormLayer:
+		t.SetForm(value)
+	case LemmaLayer:
+		t.SetLemma(value)
+	case CoarsePosTagLayer:
+		t.SetCoarsePosTag(value)
+	case PosTagLayer:
+		t.SetPosTag(value)
+	case FeaturesLayer:
+		t.features = NewFeatures(value)
+		t.available |= featuresBit
+	case HeadRelLayer:
+		t.SetHeadRel(value)
+	case PHeadRelLayer:
+		t.SetPHeadRel(value)
+	}
+
+	return t
+}
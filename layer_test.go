@@ -0,0 +1,41 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conllx
+
+import "testing"
+
+func TestLayer(t *testing.T) {
+	token := NewToken()
+	for layer := FormLayer; layer <= PHeadRelLayer; layer++ {
+		if _, ok := token.Layer(layer); ok {
+			t.Fatalf("Layer %s should be absent", layer)
+		}
+
+		token.SetLayer(layer, layer.String())
+	}
+
+	if token.String() != "form\tlemma\tcpostag\tpostag\tfeats\t_\tdeprel\t_\tpdeprel" {
+		t.Fatal("Unexpected token after setting layers:", token)
+	}
+
+	for layer := FormLayer; layer <= PHeadRelLayer; layer++ {
+		if value, ok := token.Layer(layer); !ok || value != layer.String() {
+			t.Fatalf("Layer %s has incorrect value: %s", layer, value)
+		}
+	}
+}
+
+func TestParseLayer(t *testing.T) {
+	for layer := FormLayer; layer <= PHeadRelLayer; layer++ {
+		parsed, err := ParseLayer(layer.String())
+		if err != nil || parsed != layer {
+			t.Fatalf("Could not parse layer %s: %v", layer, err)
+		}
+	}
+
+	if _, err := ParseLayer("head"); err == nil {
+		t.Fatal("expected error for an unknown layer")
+	}
+}
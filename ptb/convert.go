@@ -0,0 +1,133 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ptb
+
+import (
+	"errors"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+// A RelationFunc returns the dependency relation between the head of a
+// constituent and the head of one of its dependent children.
+type RelationFunc func(parent, head, dependent *Tree) string
+
+// DefaultRelation uses the first function tag of the dependent as the
+// relation, e.g. SBJ for NP-SBJ. If the dependent does not have a
+// function tag, its category is used.
+func DefaultRelation(parent, head, dependent *Tree) string {
+	if tags := dependent.FunctionTags(); len(tags) != 0 {
+		return tags[0]
+	}
+
+	return dependent.Category()
+}
+
+// A Converter converts constituency trees to dependency trees using
+// head rules. Head rules are matched against the categories of
+// constituents (labels without function tags).
+type Converter struct {
+	rules    HeadRules
+	relation RelationFunc
+	rootRel  string
+}
+
+// NewConverter creates a converter using the given head rules. If
+// 'relation' is nil, DefaultRelation is used. The token that heads the
+// tree is attached to the root with the relation ROOT.
+func NewConverter(rules HeadRules, relation RelationFunc) *Converter {
+	if relation == nil {
+		relation = DefaultRelation
+	}
+
+	return &Converter{
+		rules:    rules,
+		relation: relation,
+		rootRel:  "ROOT",
+	}
+}
+
+// Convert converts a constituency tree to a dependency tree. Empty
+// elements (-NONE-) are removed. The tokens get the form, part-of-speech
+// tag, head, and head relation layers.
+func (c *Converter) Convert(tree *Tree) (conllx.Sentence, error) {
+	tree = tree.prune()
+	if tree == nil {
+		return nil, errors.New("Tree has no words")
+	}
+
+	preterminals := tree.Preterminals()
+	indices := make(map[*Tree]int)
+	sentence := make(conllx.Sentence, len(preterminals))
+
+	for idx, preterminal := range preterminals {
+		indices[preterminal] = idx
+		sentence[idx].SetForm(preterminal.Word).SetPosTag(preterminal.Label)
+	}
+
+	root := c.attach(tree, sentence, indices)
+	sentence[root].SetHead(0).SetHeadRel(c.rootRel)
+
+	return sentence, nil
+}
+
+// attach attaches the heads of the non-head children of 'tree' to its
+// lexical head, recursively. The index of the lexical head is returned.
+func (c *Converter) attach(tree *Tree, sentence conllx.Sentence, indices map[*Tree]int) int {
+	if tree.IsPreterminal() {
+		return indices[tree]
+	}
+
+	labels := make([]string, len(tree.Children))
+	for idx, child := range tree.Children {
+		labels[idx] = child.Category()
+	}
+
+	headIdx := c.rules.HeadChild(tree.Category(), labels)
+	headChild := tree.Children[headIdx]
+	head := c.attach(headChild, sentence, indices)
+
+	for idx, child := range tree.Children {
+		if idx == headIdx {
+			continue
+		}
+
+		dependent := c.attach(child, sentence, indices)
+		sentence[dependent].
+			SetHead(uint(head + 1)).
+			SetHeadRel(c.relation(tree, headChild, child))
+	}
+
+	return head
+}
+
+var _ conllx.SentenceReader = &DependencyReader{}
+
+// A DependencyReader reads bracketed trees and returns them as
+// dependency trees.
+type DependencyReader struct {
+	reader    *Reader
+	converter *Converter
+}
+
+// NewDependencyReader creates a reader that converts the trees of
+// 'reader' using 'converter'.
+func NewDependencyReader(reader *Reader, converter *Converter) *DependencyReader {
+	return &DependencyReader{
+		reader:    reader,
+		converter: converter,
+	}
+}
+
+// ReadSentence returns the next tree as a dependency tree. If there is
+// no more data that can be read, io.EOF is returned as the error.
+func (r *DependencyReader) ReadSentence() (conllx.Sentence, error) {
+	tree, err := r.reader.ReadTree()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.converter.Convert(tree)
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ptb
+
+import (
+	"bufio"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+type dependency struct {
+	form string
+	head uint
+	rel  string
+}
+
+func dependencies(sentence conllx.Sentence) []dependency {
+	var deps []dependency
+	for idx := range sentence {
+		form, _ := sentence[idx].Form()
+		head, _ := sentence[idx].Head()
+		rel, _ := sentence[idx].HeadRel()
+		deps = append(deps, dependency{form, head, rel})
+	}
+
+	return deps
+}
+
+func TestConvert(t *testing.T) {
+	reader := NewDependencyReader(
+		NewReader(bufio.NewReader(strings.NewReader(testTrees))),
+		NewConverter(CollinsHeadRules(), nil))
+
+	for _, expected := range [][]dependency{
+		{
+			{"The", 2, "DT"},
+			{"cat", 3, "SBJ"},
+			{"sat", 0, "ROOT"},
+			{"on", 3, "PP"},
+			{"the", 6, "DT"},
+			{"mat", 4, "NP"},
+			{".", 3, "."},
+		},
+		{
+			{"It", 2, "NP"},
+			{"purred", 0, "ROOT"},
+		},
+		{
+			{"Oh", 0, "ROOT"},
+		},
+	} {
+		sentence, err := reader.ReadSentence()
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if deps := dependencies(sentence); !reflect.DeepEqual(deps, expected) {
+			t.Fatalf("Expected dependencies:\n%v\ngot:\n%v", expected, deps)
+		}
+
+		if err := sentence.ValidateTree(); err != nil {
+			t.Fatal("Converted sentence is not a tree:", err)
+		}
+	}
+}
+
+func TestHeadRules(t *testing.T) {
+	rules, err := ReadHeadRules(strings.NewReader(`# Test rules
+VP left VB
+VP right NP
+PP right
+`))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	for _, test := range []struct {
+		parent   string
+		children []string
+		head     int
+	}{
+		{"VP", []string{"NP", "VB", "NP"}, 1},
+		{"VP", []string{"NP", "ADVP", "NP"}, 2},
+		{"VP", []string{"ADVP", "PP"}, 0},
+		{"PP", []string{"IN", "NP"}, 1},
+		{"NP", []string{"DT", "NN"}, 0},
+	} {
+		if head := rules.HeadChild(test.parent, test.children); head != test.head {
+			t.Errorf("Expected head %d for %s -> %v, got %d", test.head, test.parent, test.children, head)
+		}
+	}
+
+	if _, err := ReadHeadRules(strings.NewReader("VP up VB")); err == nil {
+		t.Fatal("Rules with an unknown direction should not be read")
+	}
+}
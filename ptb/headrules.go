@@ -0,0 +1,168 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ptb
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// A Direction is the direction in which children are searched for a
+// head.
+type Direction int
+
+const (
+	// LeftToRight searches the children from left to right.
+	LeftToRight Direction = iota
+
+	// RightToLeft searches the children from right to left.
+	RightToLeft
+)
+
+// A HeadRule selects the head child of a constituent: for each label in
+// order, the children are searched in the rule's direction for a child
+// with that label. If Labels is empty, the first child in the direction
+// is the head.
+type HeadRule struct {
+	Direction Direction
+	Labels    []string
+}
+
+// HeadRules map the label of a constituent to head rules. The rules of
+// a constituent are tried in order. If no rule finds a head, the first
+// child in the direction of the first rule is the head. Constituents
+// without rules are headed by their first child.
+type HeadRules map[string][]HeadRule
+
+// HeadChild returns the index of the head among the children, given
+// the labels of the parent and the children.
+func (r HeadRules) HeadChild(parent string, children []string) int {
+	rules := r[parent]
+	if len(rules) == 0 {
+		return 0
+	}
+
+	for _, rule := range rules {
+		if len(rule.Labels) == 0 {
+			return firstInDirection(rule.Direction, len(children))
+		}
+
+		for _, label := range rule.Labels {
+			for i := range children {
+				idx := i
+				if rule.Direction == RightToLeft {
+					idx = len(children) - 1 - i
+				}
+
+				if children[idx] == label {
+					return idx
+				}
+			}
+		}
+	}
+
+	return firstInDirection(rules[0].Direction, len(children))
+}
+
+func firstInDirection(direction Direction, n int) int {
+	if direction == RightToLeft {
+		return n - 1
+	}
+
+	return 0
+}
+
+// ReadHeadRules reads head rules from a text format with one rule per
+// line:
+//
+//	PARENT DIRECTION LABEL...
+//
+// where DIRECTION is left (search from left to right) or right (search
+// from right to left). Multiple rules for a parent are tried in the
+// order in which they are given. Empty lines and lines starting with #
+// are ignored.
+func ReadHeadRules(r io.Reader) (HeadRules, error) {
+	rules := make(HeadRules)
+	scanner := bufio.NewScanner(r)
+
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("Line %d: expected parent and direction", lineNo)
+		}
+
+		var direction Direction
+		switch fields[1] {
+		case "left":
+			direction = LeftToRight
+		case "right":
+			direction = RightToLeft
+		default:
+			return nil, fmt.Errorf("Line %d: unknown direction: %s", lineNo, fields[1])
+		}
+
+		rules[fields[0]] = append(rules[fields[0]], HeadRule{
+			Direction: direction,
+			Labels:    fields[2:],
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// The head table of Collins (1999), appendix A. The NP rules are an
+// approximation, since the original table uses sets of labels for NPs.
+const collinsHeadRules = `
+ADJP left NNS QP NN $ ADVP JJ VBN VBG ADJP JJR NP JJS DT FW RBR RBS SBAR RB
+ADVP right RB RBR RBS FW ADVP TO CD JJR JJ IN NP JJS NN
+CONJP right CC RB IN
+FRAG right
+INTJ left
+LST right LS :
+NAC left NN NNS NNP NNPS NP NAC EX $ CD QP PRP VBG JJ JJS JJR ADJP FW
+NP right POS NN NNP NNPS NNS NX JJR
+NP left NP
+NP right $ ADJP PRN
+NP right CD
+NP right JJ JJS RB QP
+NX right POS NN NNP NNPS NNS NX JJR
+PP right IN TO VBG VBN RP FW
+PRN left
+PRT right RP
+QP left $ IN NNS NN JJ RB DT CD NCD QP JJR JJS
+RRC right VP NP ADVP ADJP PP
+S left TO IN VP S SBAR ADJP UCP NP
+SBAR left WHNP WHPP WHADVP WHADJP IN DT S SQ SINV SBAR FRAG
+SBARQ left SQ S SINV SBARQ FRAG
+SINV left VBZ VBD VBP VB MD VP S SINV ADJP NP
+SQ left VBZ VBD VBP VB MD VP SQ
+UCP right
+VP left TO VBD VBN MD VBZ VB VBG VBP VP ADJP NN NNS NP
+WHADJP left CC WRB JJ ADJP
+WHADVP right CC WRB
+WHNP left WDT WP WP$ WHADJP WHPP WHNP
+WHPP right IN TO FW
+`
+
+// CollinsHeadRules returns the head rules of Collins (1999) for the
+// English Penn Treebank.
+func CollinsHeadRules() HeadRules {
+	rules, err := ReadHeadRules(strings.NewReader(collinsHeadRules))
+	if err != nil {
+		panic("ptb: invalid Collins head rules: " + err.Error())
+	}
+
+	return rules
+}
@@ -0,0 +1,175 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ptb
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// A Reader reads bracketed trees. Trees can span multiple lines and
+// multiple trees can be on one line.
+type Reader struct {
+	reader *bufio.Reader
+	line   int
+}
+
+// NewReader creates a new bracketed tree reader from a buffered I/O
+// reader. The caller is responsible for closing the provided reader.
+func NewReader(r *bufio.Reader) *Reader {
+	return &Reader{
+		reader: r,
+		line:   1,
+	}
+}
+
+type bracketToken int
+
+const (
+	tokOpen bracketToken = iota
+	tokClose
+	tokAtom
+)
+
+func (r *Reader) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("Line %d: %s", r.line, fmt.Sprintf(format, args...))
+}
+
+// next returns the next token, io.EOF is returned at the end of the
+// input.
+func (r *Reader) next() (bracketToken, string, error) {
+	for {
+		c, _, err := r.reader.ReadRune()
+		if err != nil {
+			return 0, "", err
+		}
+
+		if c == '\n' {
+			r.line++
+		}
+
+		if unicode.IsSpace(c) {
+			continue
+		}
+
+		switch c {
+		case '(':
+			return tokOpen, "(", nil
+		case ')':
+			return tokClose, ")", nil
+		}
+
+		var atom strings.Builder
+		atom.WriteRune(c)
+
+		for {
+			c, _, err := r.reader.ReadRune()
+			if err == io.EOF {
+				return tokAtom, atom.String(), nil
+			}
+			if err != nil {
+				return 0, "", err
+			}
+
+			if unicode.IsSpace(c) || c == '(' || c == ')' {
+				r.reader.UnreadRune()
+				return tokAtom, atom.String(), nil
+			}
+
+			atom.WriteRune(c)
+		}
+	}
+}
+
+// ReadTree returns the next tree. If there is no more data that can be
+// read, io.EOF is returned as the error. An outer bracket without label,
+// as used in the Penn Treebank, is removed when it has a single child.
+func (r *Reader) ReadTree() (*Tree, error) {
+	tok, value, err := r.next()
+	if err != nil {
+		return nil, err
+	}
+
+	if tok != tokOpen {
+		return nil, r.errorf("Expected '(', got '%s'", value)
+	}
+
+	tree, err := r.readTree()
+	if err != nil {
+		return nil, err
+	}
+
+	if tree.Label == "" && len(tree.Children) == 1 {
+		return tree.Children[0], nil
+	}
+
+	return tree, nil
+}
+
+// readTree reads the remainder of a tree after its opening bracket.
+func (r *Reader) readTree() (*Tree, error) {
+	tree := &Tree{}
+
+	tok, value, err := r.next()
+	if err != nil {
+		return nil, r.unexpectedEOF(err)
+	}
+
+	if tok == tokAtom {
+		tree.Label = value
+
+		tok, value, err = r.next()
+		if err != nil {
+			return nil, r.unexpectedEOF(err)
+		}
+
+		if tok == tokAtom {
+			tree.Word = value
+
+			if tok, value, err = r.next(); err != nil {
+				return nil, r.unexpectedEOF(err)
+			}
+
+			if tok != tokClose {
+				return nil, r.errorf("Expected ')' after word, got '%s'", value)
+			}
+
+			return tree, nil
+		}
+	}
+
+	for tok != tokClose {
+		if tok != tokOpen {
+			return nil, r.errorf("Expected '(' or ')', got '%s'", value)
+		}
+
+		child, err := r.readTree()
+		if err != nil {
+			return nil, err
+		}
+		tree.Children = append(tree.Children, child)
+
+		if tok, value, err = r.next(); err != nil {
+			return nil, r.unexpectedEOF(err)
+		}
+	}
+
+	if len(tree.Children) == 0 {
+		return nil, r.errorf("Constituent without children or word: %s", tree.Label)
+	}
+
+	return tree, nil
+}
+
+func (r *Reader) unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return r.errorf("Unexpected end of input in tree")
+	}
+
+	return err
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ptb
+
+import (
+	"bufio"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testTrees = `( (S (NP-SBJ (DT The) (NN cat))
+    (VP (VBD sat) (PP (IN on) (NP (DT the) (NN mat))))
+    (. .)) )
+(S (NP (PRP It)) (VP (VBD purred))) (FRAG (-NONE- *T*-1) (UH Oh))`
+
+func TestReadTree(t *testing.T) {
+	reader := NewReader(bufio.NewReader(strings.NewReader(testTrees)))
+
+	var trees []string
+	for {
+		tree, err := reader.ReadTree()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		trees = append(trees, tree.String())
+	}
+
+	expected := []string{
+		"(S (NP-SBJ (DT The) (NN cat)) (VP (VBD sat) (PP (IN on) (NP (DT the) (NN mat)))) (. .))",
+		"(S (NP (PRP It)) (VP (VBD purred)))",
+		"(FRAG (-NONE- *T*-1) (UH Oh))",
+	}
+
+	if !reflect.DeepEqual(trees, expected) {
+		t.Fatalf("Expected trees:\n%v\ngot:\n%v", expected, trees)
+	}
+}
+
+func TestReadTreeErrors(t *testing.T) {
+	for _, input := range []string{
+		"NP",
+		"(S (NP (DT The)",
+		"(S (NP))",
+		"(DT The cat)",
+	} {
+		reader := NewReader(bufio.NewReader(strings.NewReader(input)))
+		if _, err := reader.ReadTree(); err == nil || err == io.EOF {
+			t.Fatalf("Expected an error for %q, got: %v", input, err)
+		}
+	}
+}
+
+func TestLabels(t *testing.T) {
+	for _, test := range []struct {
+		label    string
+		category string
+		tags     []string
+	}{
+		{"NP-SBJ-1", "NP", []string{"SBJ"}},
+		{"PP-LOC-CLR", "PP", []string{"LOC", "CLR"}},
+		{"NP=2", "NP", nil},
+		{"-NONE-", "-NONE-", nil},
+		{"VP", "VP", nil},
+	} {
+		tree := &Tree{Label: test.label}
+		if category := tree.Category(); category != test.category {
+			t.Errorf("Expected category %s for %s, got %s", test.category, test.label, category)
+		}
+		if tags := tree.FunctionTags(); !reflect.DeepEqual(tags, test.tags) {
+			t.Errorf("Expected function tags %v for %s, got %v", test.tags, test.label, tags)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package ptb reads and writes Penn Treebank bracketed trees and
+// converts between constituency trees and dependency trees.
+package ptb
+
+import (
+	"bytes"
+	"strings"
+)
+
+// A Tree is a constituency tree. Preterminals have a label (the
+// part-of-speech tag) and a word, but no children.
+type Tree struct {
+	Label    string
+	Word     string
+	Children []*Tree
+}
+
+// IsPreterminal returns true if the tree is a preterminal.
+func (t *Tree) IsPreterminal() bool {
+	return len(t.Children) == 0
+}
+
+// Preterminals returns the preterminals of the tree in sentence order.
+func (t *Tree) Preterminals() []*Tree {
+	if t.IsPreterminal() {
+		return []*Tree{t}
+	}
+
+	var preterminals []*Tree
+	for _, child := range t.Children {
+		preterminals = append(preterminals, child.Preterminals()...)
+	}
+
+	return preterminals
+}
+
+// Category returns the label without function tags and co-indexation,
+// e.g. NP for NP-SBJ-1. Labels that start with a dash, such as -NONE-
+// and -LRB-, are returned as-is.
+func (t *Tree) Category() string {
+	if strings.HasPrefix(t.Label, "-") {
+		return t.Label
+	}
+
+	if idx := strings.IndexAny(t.Label, "-="); idx > 0 {
+		return t.Label[:idx]
+	}
+
+	return t.Label
+}
+
+// FunctionTags returns the function tags of the label, e.g. SBJ for
+// NP-SBJ-1. Numeric co-indexation is not included.
+func (t *Tree) FunctionTags() []string {
+	if strings.HasPrefix(t.Label, "-") {
+		return nil
+	}
+
+	parts := strings.FieldsFunc(t.Label, func(r rune) bool { return r == '-' || r == '=' })
+	if len(parts) < 2 {
+		return nil
+	}
+
+	var tags []string
+	for _, part := range parts[1:] {
+		if strings.Trim(part, "0123456789") != "" {
+			tags = append(tags, part)
+		}
+	}
+
+	return tags
+}
+
+// String returns the tree in bracketed format on a single line.
+func (t *Tree) String() string {
+	var buf bytes.Buffer
+	t.write(&buf)
+	return buf.String()
+}
+
+func (t *Tree) write(buf *bytes.Buffer) {
+	buf.WriteByte('(')
+	buf.WriteString(t.Label)
+
+	if t.IsPreterminal() {
+		buf.WriteByte(' ')
+		buf.WriteString(t.Word)
+	}
+
+	for _, child := range t.Children {
+		buf.WriteByte(' ')
+		child.write(buf)
+	}
+
+	buf.WriteByte(')')
+}
+
+// prune returns a copy of the tree without empty elements (-NONE-) and
+// without constituents that become empty by their removal. nil is
+// returned when the whole tree is empty.
+func (t *Tree) prune() *Tree {
+	if t.IsPreterminal() {
+		if t.Label == "-NONE-" {
+			return nil
+		}
+		return t
+	}
+
+	var children []*Tree
+	for _, child := range t.Children {
+		if pruned := child.prune(); pruned != nil {
+			children = append(children, pruned)
+		}
+	}
+
+	if len(children) == 0 {
+		return nil
+	}
+
+	return &Tree{
+		Label:    t.Label,
+		Children: children,
+	}
+}
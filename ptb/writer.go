@@ -0,0 +1,122 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ptb
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+var bracketEscapes = map[string]string{
+	"(": "-LRB-",
+	")": "-RRB-",
+}
+
+// FromDependencies returns a flat bracketing of a projective dependency
+// tree. Every token with dependents becomes a constituent that spans its
+// dependents and the token itself. The constituent is labeled with the
+// head relation of the token, or X if the token does not have a head
+// relation. The constituents of the tokens that are attached to the
+// root are wrapped in an unlabeled outer bracket.
+func FromDependencies(sentence conllx.Sentence) (*Tree, error) {
+	if len(sentence) == 0 {
+		return nil, errors.New("Cannot convert an empty sentence")
+	}
+
+	if err := sentence.ValidateTree(); err != nil {
+		return nil, err
+	}
+
+	if !sentence.IsProjective() {
+		return nil, errors.New("Cannot convert a non-projective sentence to a bracketing")
+	}
+
+	dependents := make([][]int, len(sentence)+1)
+	for idx := range sentence {
+		head, _ := sentence[idx].Head()
+		dependents[head] = append(dependents[head], idx+1)
+	}
+
+	root := &Tree{}
+	for _, dependent := range dependents[0] {
+		root.Children = append(root.Children, constituent(sentence, dependents, dependent))
+	}
+
+	return root, nil
+}
+
+// constituent returns the constituent headed by 'head' (starting at 1).
+// Dependents are in sentence order, so the head is inserted before its
+// first right dependent.
+func constituent(sentence conllx.Sentence, dependents [][]int, head int) *Tree {
+	token := &sentence[head-1]
+
+	posTag, ok := token.PosTag()
+	if !ok {
+		posTag = "X"
+	}
+
+	form, _ := token.Form()
+	if escaped, ok := bracketEscapes[form]; ok {
+		form = escaped
+	}
+
+	preterminal := &Tree{Label: posTag, Word: form}
+	if len(dependents[head]) == 0 {
+		return preterminal
+	}
+
+	label, ok := token.HeadRel()
+	if !ok {
+		label = "X"
+	}
+
+	tree := &Tree{Label: label}
+	inserted := false
+	for _, dependent := range dependents[head] {
+		if !inserted && dependent > head {
+			tree.Children = append(tree.Children, preterminal)
+			inserted = true
+		}
+
+		tree.Children = append(tree.Children, constituent(sentence, dependents, dependent))
+	}
+
+	if !inserted {
+		tree.Children = append(tree.Children, preterminal)
+	}
+
+	return tree
+}
+
+var _ conllx.SentenceWriter = &Writer{}
+
+// A Writer writes dependency trees as flat bracketings, one tree per
+// line. See FromDependencies for a description of the bracketing.
+type Writer struct {
+	writer io.Writer
+}
+
+// NewWriter creates a new bracketed tree writer.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{
+		writer: w,
+	}
+}
+
+// WriteSentence writes a sentence as a bracketed tree. An error is
+// returned if the sentence is not a projective tree.
+func (w *Writer) WriteSentence(sentence conllx.Sentence) error {
+	tree, err := FromDependencies(sentence)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(w.writer, tree.String())
+	return err
+}
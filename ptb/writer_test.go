@@ -0,0 +1,63 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ptb
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+func TestWriter(t *testing.T) {
+	reader := NewDependencyReader(
+		NewReader(bufio.NewReader(strings.NewReader(testTrees))),
+		NewConverter(CollinsHeadRules(), nil))
+	sentence, err := reader.ReadSentence()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	var buf bytes.Buffer
+	if err := NewWriter(&buf).WriteSentence(sentence); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	expected := "( (ROOT (SBJ (DT The) (NN cat)) (VBD sat) (PP (IN on) (NP (DT the) (NN mat))) (. .)))\n"
+	if buf.String() != expected {
+		t.Fatalf("Expected:\n%sgot:\n%s", expected, buf.String())
+	}
+}
+
+func TestWriterEscapes(t *testing.T) {
+	sentence := conllx.Sentence{
+		*conllx.NewToken().SetForm("(").SetPosTag("-LRB-").SetHead(2),
+		*conllx.NewToken().SetForm("yes").SetHead(0),
+	}
+
+	tree, err := FromDependencies(sentence)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if s := tree.String(); s != "( (X (-LRB- -LRB-) (X yes)))" {
+		t.Fatal("Unexpected bracketing:", s)
+	}
+}
+
+func TestWriterNonProjective(t *testing.T) {
+	sentence := conllx.Sentence{
+		*conllx.NewToken().SetForm("a").SetHead(3),
+		*conllx.NewToken().SetForm("b").SetHead(4),
+		*conllx.NewToken().SetForm("c").SetHead(0),
+		*conllx.NewToken().SetForm("d").SetHead(3),
+	}
+
+	if _, err := FromDependencies(sentence); err == nil {
+		t.Fatal("Non-projective sentences should not be converted")
+	}
+}
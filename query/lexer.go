@@ -0,0 +1,211 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package query
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type tokenType int
+
+const (
+	tokEOF tokenType = iota
+	tokWord
+	tokString
+	tokRegexp
+	tokLBracket
+	tokRBracket
+	tokLParen
+	tokRParen
+	tokAnd
+	tokOr
+	tokNot
+	tokEq
+	tokNotEq
+	tokDependent
+	tokHead
+	tokDescendant
+	tokAncestor
+	tokSibling
+	tokPrecedes
+	tokImmPrecedes
+)
+
+type token struct {
+	typ   tokenType
+	value string
+	pos   int
+}
+
+func (t token) String() string {
+	switch t.typ {
+	case tokEOF:
+		return "end of query"
+	case tokString:
+		return fmt.Sprintf("%q", t.value)
+	case tokRegexp:
+		return "/" + t.value + "/"
+	default:
+		return fmt.Sprintf("'%s'", t.value)
+	}
+}
+
+// Characters that end a word. Within node constraints, '.' and '$' are
+// word characters, so that features such as feat.case and tags such as
+// $. can be used without quoting.
+const specialChars = "[]()&|!=<>\"/"
+const relationChars = ".$"
+
+type lexer struct {
+	input  string
+	pos    int
+	depth  int
+	tokens []token
+}
+
+func lex(input string) ([]token, error) {
+	l := &lexer{input: input}
+
+	for {
+		tok, err := l.next()
+		if err != nil {
+			return nil, err
+		}
+
+		l.tokens = append(l.tokens, tok)
+
+		if tok.typ == tokEOF {
+			return l.tokens, nil
+		}
+	}
+}
+
+func (l *lexer) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("Position %d: %s", l.pos, fmt.Sprintf(format, args...))
+}
+
+func (l *lexer) next() (token, error) {
+	for l.pos < len(l.input) {
+		r, size := utf8.DecodeRuneInString(l.input[l.pos:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		l.pos += size
+	}
+
+	start := l.pos
+
+	if l.pos == len(l.input) {
+		return token{tokEOF, "", start}, nil
+	}
+
+	// Operators, longest match first.
+	for _, op := range []struct {
+		text string
+		typ  tokenType
+	}{
+		{"!=", tokNotEq},
+		{">>", tokDescendant},
+		{"<<", tokAncestor},
+		{"..", tokPrecedes},
+		{"[", tokLBracket},
+		{"]", tokRBracket},
+		{"(", tokLParen},
+		{")", tokRParen},
+		{"&", tokAnd},
+		{"|", tokOr},
+		{"!", tokNot},
+		{"=", tokEq},
+		{">", tokDependent},
+		{"<", tokHead},
+		{"$", tokSibling},
+		{".", tokImmPrecedes},
+	} {
+		if !strings.HasPrefix(l.input[l.pos:], op.text) {
+			continue
+		}
+
+		// Within brackets, '.' and '$' start words.
+		if l.depth > 0 && strings.ContainsAny(op.text, relationChars) {
+			continue
+		}
+
+		switch op.typ {
+		case tokLBracket:
+			l.depth++
+		case tokRBracket:
+			l.depth--
+		}
+
+		l.pos += len(op.text)
+		return token{op.typ, op.text, start}, nil
+	}
+
+	switch l.input[l.pos] {
+	case '"':
+		return l.delimited(tokString)
+	case '/':
+		return l.delimited(tokRegexp)
+	}
+
+	for l.pos < len(l.input) {
+		r, size := utf8.DecodeRuneInString(l.input[l.pos:])
+		if unicode.IsSpace(r) || strings.ContainsRune(specialChars, r) ||
+			(l.depth == 0 && strings.ContainsRune(relationChars, r)) {
+			break
+		}
+		l.pos += size
+	}
+
+	return token{tokWord, l.input[start:l.pos], start}, nil
+}
+
+// delimited lexes a string or regular expression.
+func (l *lexer) delimited(typ tokenType) (token, error) {
+	start := l.pos
+
+	value, n, err := ScanDelimited(l.input[l.pos:])
+	if err != nil {
+		return token{}, l.errorf("%s", err)
+	}
+
+	l.pos += n
+	return token{typ, value, start}, nil
+}
+
+// ScanDelimited scans the string or regular expression at the start of
+// 's', which is delimited by double quotes (") or slashes (/) as in
+// queries. The delimiter can be escaped using a backslash, other
+// escapes are preserved. It returns the value without delimiters and
+// the number of bytes of 's' that were scanned.
+func ScanDelimited(s string) (string, int, error) {
+	if len(s) == 0 || (s[0] != '"' && s[0] != '/') {
+		return "", 0, errors.New("Expected \" or /")
+	}
+
+	delim := s[0]
+
+	var value strings.Builder
+	for pos := 1; pos < len(s); {
+		c := s[pos]
+
+		switch {
+		case c == delim:
+			return value.String(), pos + 1, nil
+		case c == '\\' && pos+1 < len(s) && s[pos+1] == delim:
+			value.WriteByte(delim)
+			pos += 2
+		default:
+			value.WriteByte(c)
+			pos++
+		}
+	}
+
+	return "", 0, fmt.Errorf("Unterminated %c", delim)
+}
@@ -0,0 +1,456 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package query
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+type relOp int
+
+const (
+	relDependent relOp = iota
+	relHead
+	relDescendant
+	relAncestor
+	relSibling
+	relPrecedes
+	relImmPrecedes
+)
+
+var relOps = map[tokenType]relOp{
+	tokDependent:   relDependent,
+	tokHead:        relHead,
+	tokDescendant:  relDescendant,
+	tokAncestor:    relAncestor,
+	tokSibling:     relSibling,
+	tokPrecedes:    relPrecedes,
+	tokImmPrecedes: relImmPrecedes,
+}
+
+type node struct {
+	constraint constraint
+	name       string
+	relations  []*relation
+}
+
+type relation struct {
+	op      relOp
+	label   valueMatcher
+	negated bool
+	target  *node
+}
+
+// A valueMatcher matches a string value, either exactly or using a regular
+// expression.
+type valueMatcher interface {
+	MatchString(s string) bool
+}
+
+type exactMatcher string
+
+func (m exactMatcher) MatchString(s string) bool {
+	return string(m) == s
+}
+
+type constraint interface {
+	matches(token *conllx.Token) bool
+}
+
+type trueConstraint struct{}
+
+func (trueConstraint) matches(token *conllx.Token) bool {
+	return true
+}
+
+type andConstraint []constraint
+
+func (c andConstraint) matches(token *conllx.Token) bool {
+	for _, conjunct := range c {
+		if !conjunct.matches(token) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type orConstraint []constraint
+
+func (c orConstraint) matches(token *conllx.Token) bool {
+	for _, disjunct := range c {
+		if disjunct.matches(token) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type notConstraint struct {
+	constraint constraint
+}
+
+func (c notConstraint) matches(token *conllx.Token) bool {
+	return !c.constraint.matches(token)
+}
+
+type attribute func(token *conllx.Token) (string, bool)
+
+// attrConstraint matches a token attribute. Absent attributes never
+// match, so they do match a negated constraint.
+type attrConstraint struct {
+	attribute attribute
+	value     valueMatcher
+	negated   bool
+}
+
+func (c attrConstraint) matches(token *conllx.Token) bool {
+	value, ok := c.attribute(token)
+	return (ok && c.value.MatchString(value)) != c.negated
+}
+
+func layerAttribute(layer conllx.Layer) attribute {
+	return func(token *conllx.Token) (string, bool) {
+		return token.Layer(layer)
+	}
+}
+
+func featureAttribute(name string) attribute {
+	return func(token *conllx.Token) (string, bool) {
+		features, ok := token.Features()
+		if !ok {
+			return "", false
+		}
+
+		value, ok := features.FeaturesMap()[name]
+		return value, ok
+	}
+}
+
+var attributes = map[string]attribute{
+	"form":    layerAttribute(conllx.FormLayer),
+	"lemma":   layerAttribute(conllx.LemmaLayer),
+	"cpos":    layerAttribute(conllx.CoarsePosTagLayer),
+	"cpostag": layerAttribute(conllx.CoarsePosTagLayer),
+	"pos":     layerAttribute(conllx.PosTagLayer),
+	"postag":  layerAttribute(conllx.PosTagLayer),
+	"feats":   layerAttribute(conllx.FeaturesLayer),
+	"rel":     layerAttribute(conllx.HeadRelLayer),
+	"deprel":  layerAttribute(conllx.HeadRelLayer),
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+	names  map[string]bool
+}
+
+func parse(query string) (*node, error) {
+	tokens, err := lex(query)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{
+		tokens: tokens,
+		names:  make(map[string]bool),
+	}
+
+	root, err := p.parsePattern()
+	if err != nil {
+		return nil, err
+	}
+
+	if tok := p.peek(); tok.typ != tokEOF {
+		return nil, p.errorf(tok, "Unexpected %s", tok)
+	}
+
+	return root, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.typ != tokEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) expect(typ tokenType, what string) (token, error) {
+	tok := p.next()
+	if tok.typ != typ {
+		return tok, p.errorf(tok, "Expected %s, got %s", what, tok)
+	}
+
+	return tok, nil
+}
+
+func (p *parser) errorf(tok token, format string, args ...interface{}) error {
+	return fmt.Errorf("Position %d: %s", tok.pos, fmt.Sprintf(format, args...))
+}
+
+// pattern := node relation*
+func (p *parser) parsePattern() (*node, error) {
+	n, err := p.parseNode()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		tok := p.peek()
+		if _, ok := relOps[tok.typ]; !ok && tok.typ != tokNot {
+			return n, nil
+		}
+
+		rel, err := p.parseRelation()
+		if err != nil {
+			return nil, err
+		}
+
+		n.relations = append(n.relations, rel)
+	}
+}
+
+// node := '[' expr? ']' ('=' name)? | '(' pattern ')'
+func (p *parser) parseNode() (*node, error) {
+	tok := p.next()
+
+	switch tok.typ {
+	case tokLParen:
+		n, err := p.parsePattern()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := p.expect(tokRParen, "')'"); err != nil {
+			return nil, err
+		}
+
+		return n, nil
+	case tokLBracket:
+		n := &node{constraint: trueConstraint{}}
+
+		if p.peek().typ != tokRBracket {
+			c, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			n.constraint = c
+		}
+
+		if _, err := p.expect(tokRBracket, "']'"); err != nil {
+			return nil, err
+		}
+
+		if p.peek().typ == tokEq {
+			p.next()
+
+			name, err := p.expect(tokWord, "node name")
+			if err != nil {
+				return nil, err
+			}
+
+			if p.names[name.value] {
+				return nil, p.errorf(name, "Duplicate node name: %s", name.value)
+			}
+
+			p.names[name.value] = true
+			n.name = name.value
+		}
+
+		return n, nil
+	default:
+		return nil, p.errorf(tok, "Expected node, got %s", tok)
+	}
+}
+
+// relation := '!'? op label? node
+func (p *parser) parseRelation() (*relation, error) {
+	rel := &relation{}
+
+	if p.peek().typ == tokNot {
+		p.next()
+		rel.negated = true
+	}
+
+	tok := p.next()
+	op, ok := relOps[tok.typ]
+	if !ok {
+		return nil, p.errorf(tok, "Expected relation, got %s", tok)
+	}
+	rel.op = op
+
+	switch p.peek().typ {
+	case tokWord, tokString, tokRegexp:
+		labelTok := p.peek()
+		if op != relDependent && op != relHead {
+			return nil, p.errorf(labelTok, "Relation %s cannot have a label", tok)
+		}
+
+		label, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		rel.label = label
+	}
+
+	names := p.names
+	if rel.negated {
+		// Nodes in negated relations are not bound, so their names
+		// cannot be used.
+		p.names = map[string]bool{}
+	}
+
+	target, err := p.parseNode()
+	if err != nil {
+		return nil, err
+	}
+
+	if rel.negated {
+		if len(p.names) != 0 {
+			return nil, p.errorf(tok, "Nodes in negated relations cannot be named")
+		}
+		p.names = names
+	}
+
+	rel.target = target
+
+	return rel, nil
+}
+
+// expr := and ('|' and)*
+func (p *parser) parseOr() (constraint, error) {
+	var disjuncts orConstraint
+
+	for {
+		c, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		disjuncts = append(disjuncts, c)
+
+		if p.peek().typ != tokOr {
+			break
+		}
+		p.next()
+	}
+
+	if len(disjuncts) == 1 {
+		return disjuncts[0], nil
+	}
+
+	return disjuncts, nil
+}
+
+// and := unary ('&' unary)*
+func (p *parser) parseAnd() (constraint, error) {
+	var conjuncts andConstraint
+
+	for {
+		c, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		conjuncts = append(conjuncts, c)
+
+		if p.peek().typ != tokAnd {
+			break
+		}
+		p.next()
+	}
+
+	if len(conjuncts) == 1 {
+		return conjuncts[0], nil
+	}
+
+	return conjuncts, nil
+}
+
+// unary := '!' unary | '(' expr ')' | attr ('=' | '!=') value
+func (p *parser) parseUnary() (constraint, error) {
+	tok := p.next()
+
+	switch tok.typ {
+	case tokNot:
+		c, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notConstraint{c}, nil
+	case tokLParen:
+		c, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := p.expect(tokRParen, "')'"); err != nil {
+			return nil, err
+		}
+
+		return c, nil
+	case tokWord:
+		attr, err := p.attribute(tok)
+		if err != nil {
+			return nil, err
+		}
+
+		op := p.next()
+		if op.typ != tokEq && op.typ != tokNotEq {
+			return nil, p.errorf(op, "Expected '=' or '!=', got %s", op)
+		}
+
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+
+		return attrConstraint{
+			attribute: attr,
+			value:     value,
+			negated:   op.typ == tokNotEq,
+		}, nil
+	default:
+		return nil, p.errorf(tok, "Expected constraint, got %s", tok)
+	}
+}
+
+func (p *parser) attribute(tok token) (attribute, error) {
+	if attr, ok := attributes[tok.value]; ok {
+		return attr, nil
+	}
+
+	if strings.HasPrefix(tok.value, "feat.") && len(tok.value) > len("feat.") {
+		return featureAttribute(tok.value[len("feat."):]), nil
+	}
+
+	return nil, p.errorf(tok, "Unknown attribute: %s", tok.value)
+}
+
+// value := word | string | regexp
+func (p *parser) parseValue() (valueMatcher, error) {
+	tok := p.next()
+
+	switch tok.typ {
+	case tokWord, tokString:
+		return exactMatcher(tok.value), nil
+	case tokRegexp:
+		re, err := regexp.Compile("^(?:" + tok.value + ")$")
+		if err != nil {
+			return nil, p.errorf(tok, "Invalid regular expression: %s", err)
+		}
+		return re, nil
+	default:
+		return nil, p.errorf(tok, "Expected value, got %s", tok)
+	}
+}
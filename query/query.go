@@ -0,0 +1,338 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package query implements a small query language for searching
+// dependency treebanks, in the spirit of Tgrep and Semgrex.
+//
+// A query consists of a node, optionally followed by relations to other
+// nodes. A node is a list of constraints between brackets:
+//
+//	[pos=VERB & lemma=/be|have/]
+//
+// Constraints test the attributes form, lemma, cpos (or cpostag), pos
+// (or postag), feats (the features string), rel (or deprel), and
+// feat.NAME (the value of the feature NAME). Values are words, quoted
+// strings, or regular expressions between slashes that must match the
+// full value. '=' tests for equality and '!=' for inequality, where an
+// absent attribute is unequal to any value. Constraints can be combined
+// using & (and), | (or), ! (not), and parentheses. The empty node []
+// matches any token.
+//
+// Relations between the first node of a (sub)query A and a node B are:
+//
+//	A > B    B is a dependent of A
+//	A >l B   B is a dependent of A with relation l
+//	A < B    B is the head of A
+//	A <l B   B is the head of A, and A is attached with relation l
+//	A >> B   A is an ancestor of B
+//	A << B   A is a descendant of B
+//	A $ B    A and B are siblings
+//	A . B    A immediately precedes B
+//	A .. B   A precedes B
+//
+// Relation labels can be words, quoted strings, or regular expressions.
+// All relations of a sequence apply to the first node, so a verb with
+// two obj dependents is:
+//
+//	[cpos=VERB] >obj [] >obj []
+//
+// Parentheses group a node with its relations, e.g. a noun whose head
+// is a preposition that is attached to a verb:
+//
+//	[cpos=N] < ([cpos=P] < [cpos=V])
+//
+// Relations can be negated with '!', a verb without subject is:
+//
+//	[cpos=V] !>subj []
+//
+// Every node of a query matches a different token. A node can be named
+// by appending =name, e.g. [pos=VERB]=verb. Within node constraints, '.'
+// and '$' are word characters, so tags like $. can be used unquoted.
+package query
+
+import "gopkg.in/danieldk/conllx.v1"
+
+// A Pattern is a compiled query.
+type Pattern struct {
+	query string
+	root  *node
+	nodes []*node
+}
+
+// A Match is a binding of the nodes of a pattern to tokens.
+type Match struct {
+	// Nodes contains the token index (starting at 0) of each node,
+	// in the order in which the nodes occur in the query. Nodes of
+	// negated relations are not included.
+	Nodes []int
+
+	// Names maps node names to token indices.
+	Names map[string]int
+}
+
+// Compile parses a query.
+func Compile(query string) (*Pattern, error) {
+	root, err := parse(query)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Pattern{
+		query: query,
+		root:  root,
+	}
+	p.collectNodes(root)
+
+	return p, nil
+}
+
+// MustCompile parses a query and panics when the query is invalid.
+func MustCompile(query string) *Pattern {
+	p, err := Compile(query)
+	if err != nil {
+		panic("query: Compile(" + query + "): " + err.Error())
+	}
+
+	return p
+}
+
+func (p *Pattern) collectNodes(n *node) {
+	p.nodes = append(p.nodes, n)
+	for _, rel := range n.relations {
+		if !rel.negated {
+			p.collectNodes(rel.target)
+		}
+	}
+}
+
+// Names returns the names of the named nodes of the pattern, in the
+// order in which they occur in the query.
+func (p *Pattern) Names() []string {
+	var names []string
+	for _, n := range p.nodes {
+		if n.name != "" {
+			names = append(names, n.name)
+		}
+	}
+
+	return names
+}
+
+// String returns the query that the pattern was compiled from.
+func (p *Pattern) String() string {
+	return p.query
+}
+
+// MatchSentence returns true if the pattern matches the sentence.
+func (p *Pattern) MatchSentence(sentence conllx.Sentence) bool {
+	found := false
+	m := newMatcher(sentence)
+	m.matchRoot(p.root, func() bool {
+		found = true
+		return false
+	})
+
+	return found
+}
+
+// FindAll returns all matches of the pattern in a sentence. Matches are
+// ordered by the token indices of the nodes.
+func (p *Pattern) FindAll(sentence conllx.Sentence) []Match {
+	var matches []Match
+
+	m := newMatcher(sentence)
+	m.matchRoot(p.root, func() bool {
+		match := Match{
+			Nodes: make([]int, len(p.nodes)),
+			Names: make(map[string]int),
+		}
+
+		for idx, n := range p.nodes {
+			match.Nodes[idx] = m.bindings[n]
+			if n.name != "" {
+				match.Names[n.name] = m.bindings[n]
+			}
+		}
+
+		matches = append(matches, match)
+		return true
+	})
+
+	return matches
+}
+
+type matcher struct {
+	sentence conllx.Sentence
+	heads    []int
+	used     []bool
+	bindings map[*node]int
+}
+
+func newMatcher(sentence conllx.Sentence) *matcher {
+	heads := make([]int, len(sentence))
+	for idx := range sentence {
+		if head, ok := sentence[idx].Head(); ok && int(head) <= len(sentence) {
+			heads[idx] = int(head) - 1
+		} else {
+			heads[idx] = -1
+		}
+	}
+
+	return &matcher{
+		sentence: sentence,
+		heads:    heads,
+		used:     make([]bool, len(sentence)),
+		bindings: make(map[*node]int),
+	}
+}
+
+// matchRoot tries to match the pattern rooted at 'n' at every token.
+// For every match, 'k' is called, matching stops when 'k' returns false.
+func (m *matcher) matchRoot(n *node, k func() bool) bool {
+	for idx := range m.sentence {
+		if !m.matchNode(n, idx, k) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchNode binds 'n' to token 'idx' and matches its relations. The
+// return value is false when matching should stop.
+func (m *matcher) matchNode(n *node, idx int, k func() bool) bool {
+	if m.used[idx] || !n.constraint.matches(&m.sentence[idx]) {
+		return true
+	}
+
+	m.used[idx] = true
+	m.bindings[n] = idx
+	defer func() {
+		m.used[idx] = false
+		delete(m.bindings, n)
+	}()
+
+	return m.matchRelations(n.relations, idx, k)
+}
+
+func (m *matcher) matchRelations(relations []*relation, idx int, k func() bool) bool {
+	if len(relations) == 0 {
+		return k()
+	}
+
+	rel := relations[0]
+	rest := relations[1:]
+
+	if rel.negated {
+		if m.existsRelated(rel, idx) {
+			return true
+		}
+
+		return m.matchRelations(rest, idx, k)
+	}
+
+	for _, candidate := range m.related(rel, idx) {
+		cont := m.matchNode(rel.target, candidate, func() bool {
+			return m.matchRelations(rest, idx, k)
+		})
+
+		if !cont {
+			return false
+		}
+	}
+
+	return true
+}
+
+// existsRelated checks whether the target of a negated relation can be
+// matched. Tokens of the enclosing match can be used.
+func (m *matcher) existsRelated(rel *relation, idx int) bool {
+	for _, candidate := range m.related(rel, idx) {
+		sub := newMatcher(m.sentence)
+		sub.used[idx] = true
+
+		found := false
+		sub.matchNode(rel.target, candidate, func() bool {
+			found = true
+			return false
+		})
+
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
+
+// related returns the candidate tokens for the target of a relation of
+// the token 'idx'.
+func (m *matcher) related(rel *relation, idx int) []int {
+	var candidates []int
+
+	switch rel.op {
+	case relDependent:
+		for dep, head := range m.heads {
+			if head == idx && m.labelMatches(rel, dep) {
+				candidates = append(candidates, dep)
+			}
+		}
+	case relHead:
+		if head := m.heads[idx]; head != -1 && m.labelMatches(rel, idx) {
+			candidates = append(candidates, head)
+		}
+	case relDescendant:
+		for other := range m.sentence {
+			if m.dominates(idx, other) {
+				candidates = append(candidates, other)
+			}
+		}
+	case relAncestor:
+		for other := range m.sentence {
+			if m.dominates(other, idx) {
+				candidates = append(candidates, other)
+			}
+		}
+	case relSibling:
+		for other, head := range m.heads {
+			if other != idx && head != -1 && head == m.heads[idx] {
+				candidates = append(candidates, other)
+			}
+		}
+	case relPrecedes:
+		for other := idx + 1; other < len(m.sentence); other++ {
+			candidates = append(candidates, other)
+		}
+	case relImmPrecedes:
+		if idx+1 < len(m.sentence) {
+			candidates = append(candidates, idx+1)
+		}
+	}
+
+	return candidates
+}
+
+func (m *matcher) labelMatches(rel *relation, dependent int) bool {
+	if rel.label == nil {
+		return true
+	}
+
+	label, ok := m.sentence[dependent].HeadRel()
+	return ok && rel.label.MatchString(label)
+}
+
+// dominates returns true if 'ancestor' is a proper ancestor of 'token'.
+// Cycles are not followed.
+func (m *matcher) dominates(ancestor, token int) bool {
+	token = m.heads[token]
+	for steps := 0; token != -1 && steps < len(m.heads); steps++ {
+		if token == ancestor {
+			return true
+		}
+
+		token = m.heads[token]
+	}
+
+	return false
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package query
+
+import (
+	"bufio"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+const testFragment string = `1	John	John	N	NNP	num:sg	2	nsubj
+2	gave	give	V	VBD	tense:past	0	root
+3	Mary	Mary	N	NNP	num:sg	2	obj
+4	a	a	D	DT	_	5	det
+5	book	book	N	NN	num:sg	2	obj
+6	in	in	P	IN	_	7	case
+7	Paris	Paris	N	NNP	num:sg	5	nmod
+8	.	.	$.	$.	_	2	punct`
+
+func testSentence(t *testing.T) conllx.Sentence {
+	reader := conllx.NewReader(bufio.NewReader(strings.NewReader(testFragment)))
+	sentence, err := reader.ReadSentence()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	return sentence
+}
+
+type queryTestCase struct {
+	query   string
+	matches [][]int
+}
+
+var queryTestCases = []queryTestCase{
+	{"[form=John]", [][]int{{0}}},
+	{"[cpos=N & !pos=NN]", [][]int{{0}, {2}, {6}}},
+	{"[lemma=/g.*/ | lemma=a]", [][]int{{1}, {3}}},
+	{"[feat.num=sg & feat.tense!=past & form!=/[A-Z].*/]", [][]int{{4}}},
+	{`[pos="$."]`, [][]int{{7}}},
+	{"[pos=$.]", [][]int{{7}}},
+	{"[cpos=V] >obj [] >obj []", [][]int{{1, 2, 4}, {1, 4, 2}}},
+	{"[cpos=V] >obj [] >obj [] >obj []", nil},
+	{"[cpos=N] < [cpos=P]", nil},
+	{"[cpos=P] <case [cpos=N]", [][]int{{5, 6}}},
+	{"[cpos=N] < ([cpos=N] < [cpos=V])", [][]int{{6, 4, 1}}},
+	{"[cpos=V] >> [cpos=P]", [][]int{{1, 5}}},
+	{"[cpos=P] << [form=book]", [][]int{{5, 4}}},
+	{"[form=John] $ [rel=/obj|punct/]", [][]int{{0, 2}, {0, 4}, {0, 7}}},
+	{"[cpos=D] . [cpos=N]", [][]int{{3, 4}}},
+	{"[cpos=N] .. [cpos=D]", [][]int{{0, 3}, {2, 3}}},
+	{"[cpos=N] !> []", [][]int{{0}, {2}}},
+	{"[cpos=N & rel=obj] !>det []", [][]int{{2}}},
+	{"[] > [] > [] > [] > [] > []", nil},
+}
+
+func TestFindAll(t *testing.T) {
+	sentence := testSentence(t)
+
+	for _, testCase := range queryTestCases {
+		p, err := Compile(testCase.query)
+		if err != nil {
+			t.Fatalf("Could not compile %s: %s", testCase.query, err)
+		}
+
+		var nodes [][]int
+		for _, match := range p.FindAll(sentence) {
+			nodes = append(nodes, match.Nodes)
+		}
+
+		if !reflect.DeepEqual(nodes, testCase.matches) {
+			t.Fatalf("Query %s, expected %v, got %v", testCase.query, testCase.matches, nodes)
+		}
+
+		if p.MatchSentence(sentence) != (len(testCase.matches) != 0) {
+			t.Fatalf("Query %s, MatchSentence is inconsistent with FindAll", testCase.query)
+		}
+	}
+}
+
+func TestNames(t *testing.T) {
+	p := MustCompile("[cpos=N]=noun < [cpos=V]=verb")
+	matches := p.FindAll(testSentence(t))
+
+	if len(matches) != 3 {
+		t.Fatalf("Expected 3 matches, got %d", len(matches))
+	}
+
+	if !reflect.DeepEqual(matches[1].Names, map[string]int{"noun": 2, "verb": 1}) {
+		t.Fatal("Unexpected named nodes:", matches[1].Names)
+	}
+
+	if !reflect.DeepEqual(p.Names(), []string{"noun", "verb"}) {
+		t.Fatal("Unexpected node names:", p.Names())
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	for _, query := range []string{
+		"",
+		"[",
+		"[form]",
+		"[form=]",
+		"[unknown=a]",
+		"[form=a] >",
+		"[form=a] >> foo []",
+		"[form=/(/]",
+		`[form="a]`,
+		"[]=x > []=x",
+		"[] !> []=x",
+		"[] []",
+	} {
+		if _, err := Compile(query); err == nil {
+			t.Fatalf("Query '%s' should not compile", query)
+		}
+	}
+}
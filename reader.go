@@ -22,40 +22,60 @@ var _ SentenceReader = &Reader{}
 type Reader struct {
 	scanner *bufio.Scanner
 	eof     bool
+	fresh   bool
 	tokens  Sentence
 }
 
+// A ReaderOption configures a Reader.
+type ReaderOption func(*Reader)
+
+// FreshSentences configures a Reader to return a newly-allocated
+// sentence on every call of ReadSentence, rather than recycling the
+// backing slice of the previous sentence. This trades some speed for
+// the safety of retaining sentences without copying them.
+func FreshSentences() ReaderOption {
+	return func(r *Reader) {
+		r.fresh = true
+	}
+}
+
 // NewReader creates a new CoNLL-X reader from a buffered I/O reader.
 // The caller is responsible for closing the provided reader.
-func NewReader(r *bufio.Reader) *Reader {
+func NewReader(r *bufio.Reader, options ...ReaderOption) *Reader {
 	scanner := bufio.NewScanner(r)
 	scanner.Split(bufio.ScanLines)
-	return &Reader{
+	reader := &Reader{
 		scanner: scanner,
 		eof:     false,
 	}
-}
 
-func parseColumns(line string) ([10]string, int) {
-	var columns [10]string
+	for _, option := range options {
+		option(reader)
+	}
+
+	return reader
+}
 
+// parseColumns splits the ten CoNLL-X columns of a line. Columns after
+// the tenth column are returned in 'extra'.
+func parseColumns(line string) (columns [10]string, n int, extra []string) {
 	for i := 0; i < 10; i++ {
 		end := strings.IndexByte(line, byte('\t'))
 
 		if end == -1 {
 			if len(line) == 0 {
-				return columns, i
+				return columns, i, nil
 			}
 
 			columns[i] = line
-			return columns, i + 1
+			return columns, i + 1, nil
 		}
 
 		columns[i] = line[:end]
 		line = line[end+1:]
 	}
 
-	return columns, 10
+	return columns, 10, strings.Split(line, "\t")
 }
 
 // ReadSentence returns the next sentence. If there is no more data
@@ -63,9 +83,14 @@ func parseColumns(line string) ([10]string, int) {
 //
 // The returned Sentence slice is only valid until the next call of
 // ReadSentence. If you need to retain a sentence accross calls,
-// it is safe to make a copy.
+// it is safe to make a copy using Sentence.Clone or to construct
+// the reader with the FreshSentences option.
 func (r *Reader) ReadSentence() (sentence Sentence, err error) {
-	r.tokens = r.tokens[:0]
+	if r.fresh {
+		r.tokens = nil
+	} else {
+		r.tokens = r.tokens[:0]
+	}
 
 	if r.eof {
 		return nil, io.EOF
@@ -83,12 +108,13 @@ func (r *Reader) ReadSentence() (sentence Sentence, err error) {
 			break
 		}
 
-		parts, partsLen := parseColumns(line)
+		parts, partsLen, extra := parseColumns(line)
 
 		token, err := processToken(parts[:partsLen])
 		if err != nil {
 			return nil, err
 		}
+		token.extra = extra
 
 		r.tokens = append(r.tokens, token)
 	}
@@ -131,7 +157,7 @@ func processToken(columns []string) (Token, error) {
 
 	var featuresField *Features
 	if featuresBit != 0 {
-		featuresField = newFeatures(features)
+		featuresField = NewFeatures(features)
 	}
 
 	return Token{
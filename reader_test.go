@@ -52,13 +52,13 @@ const testFragmentMarkedEmpty string = `1	Die	die	ART	ART	nsf	2	DET	_	_
 2	Deleuze	Deleuze	N	NE	case:nominative|number:singular|gender:masculine	1	APP	_	_`
 
 var testFragmentSent1 = []Token{
-	{0x7F, "Die", "die", "ART", "ART", &Features{"nsf", nil}, 2, "DET", 0, ""},
-	{0x7F, "Großaufnahme", "Großaufnahme", "N", "NN", &Features{"nsf", nil}, 0, "ROOT", 0, ""},
+	{0x7F, "Die", "die", "ART", "ART", &Features{"nsf", nil}, 2, "DET", 0, "", nil},
+	{0x7F, "Großaufnahme", "Großaufnahme", "N", "NN", &Features{"nsf", nil}, 0, "ROOT", 0, "", nil},
 }
 
 var testFragmentSent2 = []Token{
-	{0x7F, "Gilles", "Gilles", "N", "NE", &Features{"nsm", nil}, 0, "ROOT", 0, ""},
-	{0x7F, "Deleuze", "Deleuze", "N", "NE", &Features{"case:nominative|number:singular|gender:masculine", nil}, 1, "APP", 0, ""},
+	{0x7F, "Gilles", "Gilles", "N", "NE", &Features{"nsm", nil}, 0, "ROOT", 0, "", nil},
+	{0x7F, "Deleuze", "Deleuze", "N", "NE", &Features{"case:nominative|number:singular|gender:masculine", nil}, 1, "APP", 0, "", nil},
 }
 
 var token2Features = map[string]string{
@@ -68,8 +68,8 @@ var token2Features = map[string]string{
 }
 
 var testFragmentSent2Features = []Token{
-	{0x7F, "Gilles", "Gilles", "N", "NE", &Features{"nsm", nil}, 0, "ROOT", 0, ""},
-	{0x7F, "Deleuze", "Deleuze", "N", "NE", &Features{"case:nominative|number:singular|gender:masculine", token2Features}, 1, "APP", 0, ""},
+	{0x7F, "Gilles", "Gilles", "N", "NE", &Features{"nsm", nil}, 0, "ROOT", 0, "", nil},
+	{0x7F, "Deleuze", "Deleuze", "N", "NE", &Features{"case:nominative|number:singular|gender:masculine", token2Features}, 1, "APP", 0, "", nil},
 }
 
 func equalOrFail(t *testing.T, err error, correct, test []Token) {
@@ -132,6 +132,20 @@ func TestCopySafe(t *testing.T) {
 	equalOrFail(t, nil, longShortSentence1, s1Copy)
 }
 
+func TestFreshSentences(t *testing.T) {
+	reader := strings.NewReader(longShortFragment)
+	r := NewReader(bufio.NewReader(reader), FreshSentences())
+
+	s1, err := r.ReadSentence()
+	equalOrFail(t, err, longShortSentence1, s1)
+
+	s2, err := r.ReadSentence()
+	equalOrFail(t, err, longShortSentence2, s2)
+
+	// s1 should still be valid, since the slice is not recycled
+	equalOrFail(t, nil, longShortSentence1, s1)
+}
+
 func TestCorrect(t *testing.T) {
 	testHelper(t, testFragment)
 }
@@ -0,0 +1,112 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package render
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func dotString(s string) string {
+	return `"` + dotEscaper.Replace(s) + `"`
+}
+
+// WriteDOT writes a dependency tree as a Graphviz DOT graph. The tokens
+// are nodes in sentence order, labeled with their forms and
+// part-of-speech tags. Arcs point from heads to dependents.
+func WriteDOT(w io.Writer, sentence conllx.Sentence) error {
+	treeArcs, err := arcs(sentence)
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "digraph sentence {")
+	fmt.Fprintln(bw, "  node [shape=plaintext];")
+	writeDOTTree(bw, "  ", "n", sentence, treeArcs)
+	fmt.Fprintln(bw, "}")
+
+	return bw.Flush()
+}
+
+// WriteDiffDOT writes the gold and system dependency trees as two
+// clusters of a Graphviz DOT graph. System arcs with a wrong head are
+// red, system arcs with a correct head but a wrong label have a red
+// label.
+func WriteDiffDOT(w io.Writer, gold, system conllx.Sentence) error {
+	goldArcs, err := arcs(gold)
+	if err != nil {
+		return err
+	}
+
+	systemArcs, err := diffArcs(gold, system)
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "digraph sentence {")
+	fmt.Fprintln(bw, "  node [shape=plaintext];")
+
+	fmt.Fprintln(bw, "  subgraph cluster_gold {")
+	fmt.Fprintln(bw, `    label="gold";`)
+	writeDOTTree(bw, "    ", "g", gold, goldArcs)
+	fmt.Fprintln(bw, "  }")
+
+	fmt.Fprintln(bw, "  subgraph cluster_system {")
+	fmt.Fprintln(bw, `    label="system";`)
+	writeDOTTree(bw, "    ", "s", system, systemArcs)
+	fmt.Fprintln(bw, "  }")
+
+	fmt.Fprintln(bw, "}")
+
+	return bw.Flush()
+}
+
+// writeDOTTree writes the nodes and arcs of a tree. Node identifiers
+// are the prefix followed by the token index, the root has index 0.
+func writeDOTTree(w io.Writer, indent, prefix string, sentence conllx.Sentence, treeArcs []arc) {
+	fmt.Fprintf(w, "%s%s0 [label=\"ROOT\"];\n", indent, prefix)
+	for idx := range sentence {
+		form, tag := tokenLabel(&sentence[idx])
+		label := form
+		if tag != "" {
+			label += "\n" + tag
+		}
+
+		fmt.Fprintf(w, "%s%s%d [label=%s];\n", indent, prefix, idx+1, dotString(label))
+	}
+
+	// Keep the tokens in sentence order using invisible edges.
+	nodes := make([]string, len(sentence)+1)
+	for idx := range nodes {
+		nodes[idx] = fmt.Sprintf("%s%d", prefix, idx)
+	}
+	fmt.Fprintf(w, "%s{ rank=same; %s; }\n", indent, strings.Join(nodes, "; "))
+	if len(nodes) > 1 {
+		fmt.Fprintf(w, "%s%s [style=invis];\n", indent, strings.Join(nodes, " -> "))
+	}
+
+	for _, a := range treeArcs {
+		var attrs []string
+		attrs = append(attrs, "label="+dotString(a.label))
+
+		switch a.status {
+		case wrongHead:
+			attrs = append(attrs, "color=red", "fontcolor=red")
+		case wrongLabel:
+			attrs = append(attrs, "fontcolor=red")
+		}
+
+		fmt.Fprintf(w, "%s%s%d -> %s%d [%s];\n", indent, prefix, a.head, prefix, a.dependent,
+			strings.Join(attrs, ", "))
+	}
+}
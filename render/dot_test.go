@@ -0,0 +1,62 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package render
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+func TestWriteDOT(t *testing.T) {
+	sentence := conllx.Sentence{
+		*conllx.NewToken().SetForm(`"Hi"`).SetPosTag("UH").SetHead(2).SetHeadRel("intj"),
+		*conllx.NewToken().SetForm("there").SetHead(0).SetHeadRel("root"),
+	}
+
+	var buf bytes.Buffer
+	if err := WriteDOT(&buf, sentence); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	expected := `digraph sentence {
+  node [shape=plaintext];
+  n0 [label="ROOT"];
+  n1 [label="\"Hi\"\nUH"];
+  n2 [label="there"];
+  { rank=same; n0; n1; n2; }
+  n0 -> n1 -> n2 [style=invis];
+  n2 -> n1 [label="intj"];
+  n0 -> n2 [label="root"];
+}
+`
+
+	if buf.String() != expected {
+		t.Fatalf("Expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+}
+
+func TestWriteDiffDOT(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteDiffDOT(&buf, testGold, testSystem); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	dot := buf.String()
+	for _, expected := range []string{
+		"subgraph cluster_gold {",
+		"subgraph cluster_system {",
+		`g4 -> g6 [label="pobj"];`,
+		`s3 -> s6 [label="pobj", color=red, fontcolor=red];`,
+		`s3 -> s2 [label="dobj", fontcolor=red];`,
+		`s2 -> s1 [label="det"];`,
+	} {
+		if !strings.Contains(dot, expected) {
+			t.Errorf("Expected %q in:\n%s", expected, dot)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package render renders dependency trees as Graphviz DOT graphs and as
+// standalone SVG arc diagrams. Arcs are labeled with the head relation
+// of the dependent. Gold-standard and system trees can be rendered
+// together, highlighting the system arcs that are wrong.
+package render
+
+import (
+	"fmt"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+// An arcStatus is the correctness of a system arc.
+type arcStatus int
+
+const (
+	correctArc arcStatus = iota
+
+	// The label of the arc is wrong, but the head is correct.
+	wrongLabel
+
+	// The head of the arc is wrong.
+	wrongHead
+)
+
+// An arc is the dependency arc of a token.
+type arc struct {
+	// The indices of the head and dependent, starting at 1. The head is 0
+	// for arcs from the root.
+	head      int
+	dependent int
+	label     string
+	status    arcStatus
+}
+
+func (a arc) span() (int, int) {
+	if a.head < a.dependent {
+		return a.head, a.dependent
+	}
+
+	return a.dependent, a.head
+}
+
+// arcs returns the arcs of the tokens that have a head. An error is
+// returned when a head is out of bounds.
+func arcs(sentence conllx.Sentence) ([]arc, error) {
+	var result []arc
+
+	for idx := range sentence {
+		head, ok := sentence[idx].Head()
+		if !ok {
+			continue
+		}
+
+		if head > uint(len(sentence)) {
+			return nil, fmt.Errorf("Head of token %d is out of bounds: %d", idx+1, head)
+		}
+
+		label, _ := sentence[idx].HeadRel()
+		result = append(result, arc{
+			head:      int(head),
+			dependent: idx + 1,
+			label:     label,
+		})
+	}
+
+	return result, nil
+}
+
+// diffArcs returns the arcs of the system sentence, marking the arcs
+// that differ from the gold sentence.
+func diffArcs(gold, system conllx.Sentence) ([]arc, error) {
+	if len(gold) != len(system) {
+		return nil, fmt.Errorf("Gold sentence has %d tokens, system sentence has %d tokens",
+			len(gold), len(system))
+	}
+
+	systemArcs, err := arcs(system)
+	if err != nil {
+		return nil, err
+	}
+
+	for idx, a := range systemArcs {
+		token := &gold[a.dependent-1]
+
+		goldHead, ok := token.Head()
+		if !ok || int(goldHead) != a.head {
+			systemArcs[idx].status = wrongHead
+			continue
+		}
+
+		if goldLabel, _ := token.HeadRel(); goldLabel != a.label {
+			systemArcs[idx].status = wrongLabel
+		}
+	}
+
+	return systemArcs, nil
+}
+
+// arcLevels returns the height level of each arc, such that an arc is
+// higher than the arcs that it spans. Arcs from the root are not
+// considered, since they are drawn as vertical lines.
+func arcLevels(arcs []arc) []int {
+	levels := make([]int, len(arcs))
+
+	var level func(idx int) int
+	level = func(idx int) int {
+		if levels[idx] != 0 {
+			return levels[idx]
+		}
+
+		start, end := arcs[idx].span()
+		l := 1
+		for other := range arcs {
+			if other == idx || arcs[other].head == 0 {
+				continue
+			}
+
+			otherStart, otherEnd := arcs[other].span()
+			if otherStart >= start && otherEnd <= end && otherEnd-otherStart < end-start {
+				if otherLevel := level(other) + 1; otherLevel > l {
+					l = otherLevel
+				}
+			}
+		}
+
+		levels[idx] = l
+		return l
+	}
+
+	for idx := range arcs {
+		if arcs[idx].head != 0 {
+			level(idx)
+		}
+	}
+
+	return levels
+}
+
+func tokenLabel(token *conllx.Token) (string, string) {
+	form, _ := token.Form()
+	tag, ok := token.PosTag()
+	if !ok {
+		tag, _ = token.CoarsePosTag()
+	}
+
+	return form, tag
+}
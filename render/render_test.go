@@ -0,0 +1,66 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package render
+
+import (
+	"reflect"
+	"testing"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+func testSentence(heads []uint, rels []string) conllx.Sentence {
+	forms := []string{"The", "cat", "sat", "on", "the", "mat"}
+	tags := []string{"DT", "NN", "VBD", "IN", "DT", "NN"}
+
+	sentence := make(conllx.Sentence, len(heads))
+	for idx := range sentence {
+		sentence[idx].SetForm(forms[idx]).SetPosTag(tags[idx]).
+			SetHead(heads[idx]).SetHeadRel(rels[idx])
+	}
+
+	return sentence
+}
+
+var testGold = testSentence(
+	[]uint{2, 3, 0, 3, 6, 4},
+	[]string{"det", "nsubj", "root", "prep", "det", "pobj"})
+
+var testSystem = testSentence(
+	[]uint{2, 3, 0, 3, 6, 3},
+	[]string{"det", "dobj", "root", "prep", "det", "pobj"})
+
+func TestDiffArcs(t *testing.T) {
+	diff, err := diffArcs(testGold, testSystem)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	var statuses []arcStatus
+	for _, a := range diff {
+		statuses = append(statuses, a.status)
+	}
+
+	expected := []arcStatus{correctArc, wrongLabel, correctArc, correctArc, correctArc, wrongHead}
+	if !reflect.DeepEqual(statuses, expected) {
+		t.Fatalf("Expected %v, got %v", expected, statuses)
+	}
+
+	if _, err := diffArcs(testGold, testSystem[:5]); err == nil {
+		t.Fatal("Sentences of different lengths should not be compared")
+	}
+}
+
+func TestArcLevels(t *testing.T) {
+	treeArcs, err := arcs(testGold)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	// sat -> on spans no arcs, on -> mat spans the -> mat.
+	if levels := arcLevels(treeArcs); !reflect.DeepEqual(levels, []int{1, 1, 0, 1, 1, 2}) {
+		t.Fatal("Unexpected levels:", levels)
+	}
+}
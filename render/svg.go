@@ -0,0 +1,186 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package render
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"unicode/utf8"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+// Layout of SVG arc diagrams, in pixels.
+const (
+	svgMargin       = 10.0
+	svgCharWidth    = 8.0
+	svgTokenPadding = 16.0
+	svgLevelHeight  = 24.0
+	svgFontSize     = 14.0
+	svgTagFontSize  = 11.0
+	svgLineHeight   = 18.0
+)
+
+const svgStyle = `text { font-family: sans-serif; font-size: 14px; text-anchor: middle; }
+.tag { fill: #555555; font-size: 11px; }
+.label { font-size: 11px; }
+.arc { fill: none; stroke: black; }
+.error { stroke: red; }
+.error-label { fill: red; }`
+
+// WriteSVG writes a dependency tree as a standalone SVG arc diagram.
+// The tokens are written from left to right with their part-of-speech
+// tags. The arcs are drawn above the tokens.
+func WriteSVG(w io.Writer, sentence conllx.Sentence) error {
+	treeArcs, err := arcs(sentence)
+	if err != nil {
+		return err
+	}
+
+	return writeSVG(w, sentence, treeArcs, nil)
+}
+
+// WriteDiffSVG writes the gold and system dependency trees as a
+// standalone SVG arc diagram. The gold arcs are drawn above the tokens
+// and the system arcs below the tokens. System arcs with a wrong head
+// are red, system arcs with a correct head but a wrong label have a red
+// label.
+func WriteDiffSVG(w io.Writer, gold, system conllx.Sentence) error {
+	goldArcs, err := arcs(gold)
+	if err != nil {
+		return err
+	}
+
+	systemArcs, err := diffArcs(gold, system)
+	if err != nil {
+		return err
+	}
+
+	return writeSVG(w, gold, goldArcs, systemArcs)
+}
+
+func writeSVG(w io.Writer, sentence conllx.Sentence, above, below []arc) error {
+	// Horizontal layout: every token gets a column that fits its form
+	// and tag.
+	centers := make([]float64, len(sentence)+1)
+	x := svgMargin
+	for idx := range sentence {
+		form, tag := tokenLabel(&sentence[idx])
+		chars := utf8.RuneCountInString(form)
+		if tagChars := utf8.RuneCountInString(tag); tagChars > chars {
+			chars = tagChars
+		}
+
+		width := float64(chars)*svgCharWidth + svgTokenPadding
+		centers[idx+1] = x + width/2
+		x += width
+	}
+	width := x + svgMargin
+
+	aboveLevels := arcLevels(above)
+	belowLevels := arcLevels(below)
+
+	// Vertical layout: the arcs above, the tokens, and the arcs below.
+	aboveHeight := float64(maxLevel(aboveLevels)+1) * svgLevelHeight
+	tokensTop := svgMargin + aboveHeight
+	tokensBottom := tokensTop + 2*svgLineHeight
+	belowHeight := 0.0
+	if below != nil {
+		belowHeight = float64(maxLevel(belowLevels)+1) * svgLevelHeight
+	}
+	height := tokensBottom + belowHeight + svgMargin
+
+	var buf bytes.Buffer
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" width="%.0f" height="%.0f" viewBox="0 0 %.0f %.0f">`+"\n",
+		width, height, width, height)
+	fmt.Fprintln(bw, "<style>")
+	fmt.Fprintln(bw, svgStyle)
+	fmt.Fprintln(bw, "</style>")
+	fmt.Fprintln(bw, "<defs>")
+	fmt.Fprintln(bw, `<marker id="arrow" viewBox="0 0 10 10" refX="10" refY="5" markerWidth="6" markerHeight="6" orient="auto"><path d="M0,0 L10,5 L0,10 z" fill="black"/></marker>`)
+	fmt.Fprintln(bw, `<marker id="arrow-error" viewBox="0 0 10 10" refX="10" refY="5" markerWidth="6" markerHeight="6" orient="auto"><path d="M0,0 L10,5 L0,10 z" fill="red"/></marker>`)
+	fmt.Fprintln(bw, "</defs>")
+
+	for idx := range sentence {
+		form, tag := tokenLabel(&sentence[idx])
+		fmt.Fprintf(bw, `<text x="%.1f" y="%.1f">%s</text>`+"\n",
+			centers[idx+1], tokensTop+svgFontSize, svgEscape(&buf, form))
+		fmt.Fprintf(bw, `<text class="tag" x="%.1f" y="%.1f">%s</text>`+"\n",
+			centers[idx+1], tokensTop+svgLineHeight+svgTagFontSize, svgEscape(&buf, tag))
+	}
+
+	writeSVGArcs(bw, &buf, above, aboveLevels, centers, tokensTop, -1, svgMargin)
+	if below != nil {
+		writeSVGArcs(bw, &buf, below, belowLevels, centers, tokensBottom, 1, height-svgMargin)
+	}
+
+	fmt.Fprintln(bw, "</svg>")
+
+	return bw.Flush()
+}
+
+// writeSVGArcs writes arcs that start at the vertical position 'base'.
+// The arcs extend upwards when 'direction' is -1 and downwards when it
+// is 1. Arcs from the root are vertical lines that start at 'rootY'.
+func writeSVGArcs(w io.Writer, buf *bytes.Buffer, arcs []arc, levels []int, centers []float64,
+	base, direction, rootY float64) {
+	for idx, a := range arcs {
+		class, labelClass, marker := "arc", "label", "arrow"
+		switch a.status {
+		case wrongHead:
+			class, labelClass, marker = "arc error", "label error-label", "arrow-error"
+		case wrongLabel:
+			labelClass = "label error-label"
+		}
+
+		dependentX := centers[a.dependent]
+
+		if a.head == 0 {
+			fmt.Fprintf(w, `<path class="%s" d="M%.1f,%.1f L%.1f,%.1f" marker-end="url(#%s)"/>`+"\n",
+				class, dependentX, rootY, dependentX, base, marker)
+			fmt.Fprintf(w, `<text class="%s" x="%.1f" y="%.1f">%s</text>`+"\n",
+				labelClass, dependentX+4, rootY-direction*svgTagFontSize, svgEscape(buf, a.label))
+			continue
+		}
+
+		headX := centers[a.head]
+		h := float64(levels[idx]) * svgLevelHeight
+
+		// A cubic Bézier curve with control points at 4/3 of the height
+		// reaches the height at its peak.
+		control := base + direction*h*4/3
+		fmt.Fprintf(w, `<path class="%s" d="M%.1f,%.1f C%.1f,%.1f %.1f,%.1f %.1f,%.1f" marker-end="url(#%s)"/>`+"\n",
+			class, headX, base, headX, control, dependentX, control, dependentX, base, marker)
+
+		labelY := base + direction*h - 3
+		if direction > 0 {
+			labelY = base + direction*h + svgTagFontSize
+		}
+		fmt.Fprintf(w, `<text class="%s" x="%.1f" y="%.1f">%s</text>`+"\n",
+			labelClass, (headX+dependentX)/2, labelY, svgEscape(buf, a.label))
+	}
+}
+
+func maxLevel(levels []int) int {
+	max := 0
+	for _, level := range levels {
+		if level > max {
+			max = level
+		}
+	}
+
+	return max
+}
+
+func svgEscape(buf *bytes.Buffer, s string) string {
+	buf.Reset()
+	xml.EscapeText(buf, []byte(s))
+	return buf.String()
+}
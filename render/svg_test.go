@@ -0,0 +1,91 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package render
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+// checkSVG checks that the SVG is well-formed and returns the number of
+// elements per name.
+func checkSVG(t *testing.T, svg string) map[string]int {
+	decoder := xml.NewDecoder(strings.NewReader(svg))
+	counts := make(map[string]int)
+
+	for {
+		tok, err := decoder.Token()
+		if err == io.EOF {
+			return counts
+		}
+		if err != nil {
+			t.Fatalf("SVG is not well-formed: %s\n%s", err, svg)
+		}
+
+		if start, ok := tok.(xml.StartElement); ok {
+			counts[start.Name.Local]++
+		}
+	}
+}
+
+func TestWriteSVG(t *testing.T) {
+	sentence := append(testGold.Clone(), *conllx.NewToken().SetForm("<&>"))
+
+	var buf bytes.Buffer
+	if err := WriteSVG(&buf, sentence); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	counts := checkSVG(t, buf.String())
+
+	// Two markers, and one path per arc.
+	if counts["path"] != 2+6 {
+		t.Errorf("Expected 8 paths, got %d", counts["path"])
+	}
+
+	// A form and a tag per token, and a label per arc.
+	if counts["text"] != 2*7+6 {
+		t.Errorf("Expected 20 texts, got %d", counts["text"])
+	}
+
+	if !strings.Contains(buf.String(), "&lt;&amp;&gt;") {
+		t.Error("Forms should be escaped")
+	}
+
+	if strings.Contains(buf.String(), `class="arc error"`) {
+		t.Error("A single tree should not have errors")
+	}
+}
+
+func TestWriteDiffSVG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteDiffSVG(&buf, testGold, testSystem); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	svg := buf.String()
+	counts := checkSVG(t, svg)
+
+	if counts["path"] != 2+2*6 {
+		t.Errorf("Expected 14 paths, got %d", counts["path"])
+	}
+
+	if n := strings.Count(svg, `class="arc error"`); n != 1 {
+		t.Errorf("Expected 1 wrong arc, got %d", n)
+	}
+
+	if n := strings.Count(svg, `class="label error-label"`); n != 2 {
+		t.Errorf("Expected 2 wrong labels, got %d", n)
+	}
+
+	if err := WriteDiffSVG(io.Discard, testGold, testSystem[:2]); err == nil {
+		t.Error("Sentences of different lengths should not be rendered")
+	}
+}
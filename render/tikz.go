@@ -0,0 +1,134 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package render
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+// TikzOptions configure the export to tikz-dependency.
+type TikzOptions struct {
+	// Layers are shown as rows under the forms, e.g. LemmaLayer and
+	// PosTagLayer. Absent values are left empty.
+	Layers []conllx.Layer
+
+	// Highlight contains the dependents (starting at 1) of the arcs that
+	// are highlighted.
+	Highlight []uint
+
+	// HighlightStyle is the tikz-dependency style of highlighted arcs.
+	HighlightStyle string
+}
+
+// DefaultTikzOptions returns options that show part-of-speech tags
+// under the forms and draw highlighted arcs in red.
+func DefaultTikzOptions() TikzOptions {
+	return TikzOptions{
+		Layers:         []conllx.Layer{conllx.PosTagLayer},
+		HighlightStyle: "edge style={red, thick}, label style={text=red}",
+	}
+}
+
+var latexEscaper = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	`&`, `\symbol{38}`,
+	`%`, `\%`,
+	`$`, `\$`,
+	`#`, `\#`,
+	`_`, `\_`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`~`, `\textasciitilde{}`,
+	`^`, `\textasciicircum{}`,
+)
+
+// EscapeLaTeX escapes the characters that have a special meaning in
+// LaTeX. Ampersands are written as \symbol{38} rather than \&, since
+// \& separates the cells of a deptext matrix.
+func EscapeLaTeX(s string) string {
+	return latexEscaper.Replace(s)
+}
+
+// WriteTikz writes a dependency tree as a tikz-dependency environment.
+// Forms, layer values, and labels are escaped (see EscapeLaTeX).
+func WriteTikz(w io.Writer, sentence conllx.Sentence, options TikzOptions) error {
+	treeArcs, err := arcs(sentence)
+	if err != nil {
+		return err
+	}
+
+	for _, dependent := range options.Highlight {
+		if dependent == 0 || dependent > uint(len(sentence)) {
+			return fmt.Errorf("Highlighted arc out of bounds: %d", dependent)
+		}
+	}
+
+	highlight := make(map[int]bool)
+	for _, dependent := range options.Highlight {
+		highlight[int(dependent)] = true
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, `\begin{dependency}`)
+	fmt.Fprintln(bw, `  \begin{deptext}`)
+
+	cells := make([]string, len(sentence))
+	for idx := range sentence {
+		form, _ := sentence[idx].Form()
+		cells[idx] = EscapeLaTeX(form)
+	}
+	fmt.Fprintf(bw, "    %s \\\\\n", strings.Join(cells, ` \& `))
+
+	for _, layer := range options.Layers {
+		for idx := range sentence {
+			value, _ := sentence[idx].Layer(layer)
+			cells[idx] = EscapeLaTeX(value)
+		}
+		fmt.Fprintf(bw, "    %s \\\\\n", strings.Join(cells, ` \& `))
+	}
+
+	fmt.Fprintln(bw, `  \end{deptext}`)
+
+	for _, a := range treeArcs {
+		style := ""
+		if highlight[a.dependent] && options.HighlightStyle != "" {
+			style = "[" + options.HighlightStyle + "]"
+		}
+
+		if a.head == 0 {
+			fmt.Fprintf(bw, "  \\deproot%s{%d}{%s}\n", style, a.dependent, EscapeLaTeX(a.label))
+		} else {
+			fmt.Fprintf(bw, "  \\depedge%s{%d}{%d}{%s}\n", style, a.head, a.dependent, EscapeLaTeX(a.label))
+		}
+	}
+
+	fmt.Fprintln(bw, `\end{dependency}`)
+
+	return bw.Flush()
+}
+
+// ErrorArcs returns the dependents (starting at 1) of the system arcs
+// that have a wrong head or label. The result can be used to highlight
+// errors in tikz-dependency output.
+func ErrorArcs(gold, system conllx.Sentence) ([]uint, error) {
+	systemArcs, err := diffArcs(gold, system)
+	if err != nil {
+		return nil, err
+	}
+
+	var errors []uint
+	for _, a := range systemArcs {
+		if a.status != correctArc {
+			errors = append(errors, uint(a.dependent))
+		}
+	}
+
+	return errors, nil
+}
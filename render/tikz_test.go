@@ -0,0 +1,69 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package render
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+func TestWriteTikz(t *testing.T) {
+	sentence := conllx.Sentence{
+		*conllx.NewToken().SetForm("50%").SetLemma("50%").SetPosTag("CD").SetHead(2).SetHeadRel("num_mod"),
+		*conllx.NewToken().SetForm("off").SetPosTag("RP").SetHead(0).SetHeadRel("root"),
+		*conllx.NewToken().SetForm("{&}").SetPosTag("SYM").SetHead(2).SetHeadRel("dep"),
+	}
+
+	options := DefaultTikzOptions()
+	options.Layers = []conllx.Layer{conllx.LemmaLayer, conllx.PosTagLayer}
+	options.Highlight = []uint{3}
+
+	var buf bytes.Buffer
+	if err := WriteTikz(&buf, sentence, options); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	expected := `\begin{dependency}
+  \begin{deptext}
+    50\% \& off \& \{\symbol{38}\} \\
+    50\% \&  \&  \\
+    CD \& RP \& SYM \\
+  \end{deptext}
+  \depedge{2}{1}{num\_mod}
+  \deproot{2}{root}
+  \depedge[edge style={red, thick}, label style={text=red}]{2}{3}{dep}
+\end{dependency}
+`
+
+	if buf.String() != expected {
+		t.Fatalf("Expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+
+	options.Highlight = []uint{4}
+	if err := WriteTikz(io.Discard, sentence, options); err == nil {
+		t.Fatal("Highlighted arcs out of bounds should be rejected")
+	}
+}
+
+func TestEscapeLaTeX(t *testing.T) {
+	if escaped := EscapeLaTeX(`a\b~c^d#e$`); escaped != `a\textbackslash{}b\textasciitilde{}c\textasciicircum{}d\#e\$` {
+		t.Fatal("Unexpected escaping:", escaped)
+	}
+}
+
+func TestErrorArcs(t *testing.T) {
+	errors, err := ErrorArcs(testGold, testSystem)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if !reflect.DeepEqual(errors, []uint{2, 6}) {
+		t.Fatal("Unexpected error arcs:", errors)
+	}
+}
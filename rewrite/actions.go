@@ -0,0 +1,299 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rewrite
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+// An Action modifies a sentence. The nodes that were bound by the match
+// of a rule are provided as a mapping from node names to token indices
+// (starting at 0).
+type Action interface {
+	Apply(sentence conllx.Sentence, nodes map[string]int) error
+	String() string
+}
+
+// nodeReferrer is implemented by actions that refer to nodes, so that
+// rules can check that the nodes are named in their pattern.
+type nodeReferrer interface {
+	nodeNames() []string
+}
+
+func lookup(nodes map[string]int, name string) (int, error) {
+	idx, ok := nodes[name]
+	if !ok {
+		return 0, fmt.Errorf("Node is not bound: %s", name)
+	}
+
+	return idx, nil
+}
+
+// A Target is the new head of a reattached token.
+type Target interface {
+	resolve(sentence conllx.Sentence, nodes map[string]int) (uint, error)
+	nodeReferrer
+	fmt.Stringer
+}
+
+type nodeTarget string
+
+// Node returns the target that is the token bound to the named node.
+func Node(name string) Target {
+	return nodeTarget(name)
+}
+
+func (t nodeTarget) resolve(sentence conllx.Sentence, nodes map[string]int) (uint, error) {
+	idx, err := lookup(nodes, string(t))
+	return uint(idx + 1), err
+}
+
+func (t nodeTarget) nodeNames() []string {
+	return []string{string(t)}
+}
+
+func (t nodeTarget) String() string {
+	return string(t)
+}
+
+type rootTarget struct{}
+
+// Root returns the target that is the artificial root token.
+func Root() Target {
+	return rootTarget{}
+}
+
+func (rootTarget) resolve(sentence conllx.Sentence, nodes map[string]int) (uint, error) {
+	return 0, nil
+}
+
+func (rootTarget) nodeNames() []string {
+	return nil
+}
+
+func (rootTarget) String() string {
+	return "root"
+}
+
+type headOfTarget string
+
+// HeadOf returns the target that is the current head of the token bound
+// to the named node. The head is determined when the action is applied,
+// so it reflects earlier actions of the same rule.
+func HeadOf(name string) Target {
+	return headOfTarget(name)
+}
+
+func (t headOfTarget) resolve(sentence conllx.Sentence, nodes map[string]int) (uint, error) {
+	idx, err := lookup(nodes, string(t))
+	if err != nil {
+		return 0, err
+	}
+
+	head, ok := sentence[idx].Head()
+	if !ok {
+		return 0, fmt.Errorf("Node %s does not have a head", string(t))
+	}
+
+	return head, nil
+}
+
+func (t headOfTarget) nodeNames() []string {
+	return []string{string(t)}
+}
+
+func (t headOfTarget) String() string {
+	return fmt.Sprintf("head(%s)", string(t))
+}
+
+type reattach struct {
+	node string
+	head Target
+}
+
+// Reattach returns an action that attaches the token bound to 'node' to
+// a new head.
+func Reattach(node string, head Target) Action {
+	return reattach{node, head}
+}
+
+func (a reattach) Apply(sentence conllx.Sentence, nodes map[string]int) error {
+	idx, err := lookup(nodes, a.node)
+	if err != nil {
+		return err
+	}
+
+	head, err := a.head.resolve(sentence, nodes)
+	if err != nil {
+		return err
+	}
+
+	sentence[idx].SetHead(head)
+
+	return nil
+}
+
+func (a reattach) nodeNames() []string {
+	return append([]string{a.node}, a.head.nodeNames()...)
+}
+
+func (a reattach) String() string {
+	return fmt.Sprintf("reattach(%s, %s)", a.node, a.head)
+}
+
+type relabel struct {
+	node string
+	rel  string
+}
+
+// Relabel returns an action that changes the relation of the token
+// bound to 'node' to its head.
+func Relabel(node, rel string) Action {
+	return relabel{node, rel}
+}
+
+func (a relabel) Apply(sentence conllx.Sentence, nodes map[string]int) error {
+	idx, err := lookup(nodes, a.node)
+	if err != nil {
+		return err
+	}
+
+	sentence[idx].SetHeadRel(a.rel)
+
+	return nil
+}
+
+func (a relabel) nodeNames() []string {
+	return []string{a.node}
+}
+
+func (a relabel) String() string {
+	return fmt.Sprintf("relabel(%s, %s)", a.node, a.rel)
+}
+
+type setLayer struct {
+	node  string
+	layer conllx.Layer
+	value string
+}
+
+// SetLayer returns an action that sets an annotation layer, such as the
+// part-of-speech tag, of the token bound to 'node'.
+func SetLayer(node string, layer conllx.Layer, value string) Action {
+	return setLayer{node, layer, value}
+}
+
+func (a setLayer) Apply(sentence conllx.Sentence, nodes map[string]int) error {
+	idx, err := lookup(nodes, a.node)
+	if err != nil {
+		return err
+	}
+
+	sentence[idx].SetLayer(a.layer, a.value)
+
+	return nil
+}
+
+func (a setLayer) nodeNames() []string {
+	return []string{a.node}
+}
+
+func (a setLayer) String() string {
+	return fmt.Sprintf("set(%s, %s, %s)", a.node, a.layer, a.value)
+}
+
+type setFeature struct {
+	node    string
+	feature string
+	value   string
+}
+
+// SetFeature returns an action that sets a feature of the token bound
+// to 'node'. The order of the other features is preserved, a feature
+// that is not present yet is added at the end.
+func SetFeature(node, feature, value string) Action {
+	return setFeature{node, feature, value}
+}
+
+func (a setFeature) Apply(sentence conllx.Sentence, nodes map[string]int) error {
+	idx, err := lookup(nodes, a.node)
+	if err != nil {
+		return err
+	}
+
+	var avs []string
+	if features, ok := sentence[idx].Features(); ok && features.FeaturesString() != "" {
+		avs = strings.Split(features.FeaturesString(), "|")
+	}
+
+	av := a.feature + ":" + a.value
+
+	replaced := false
+	for i := range avs {
+		if strings.HasPrefix(avs[i], a.feature+":") {
+			avs[i] = av
+			replaced = true
+		}
+	}
+
+	if !replaced {
+		avs = append(avs, av)
+	}
+
+	sentence[idx].SetLayer(conllx.FeaturesLayer, strings.Join(avs, "|"))
+
+	return nil
+}
+
+func (a setFeature) nodeNames() []string {
+	return []string{a.node}
+}
+
+func (a setFeature) String() string {
+	return fmt.Sprintf("setfeat(%s, %s, %s)", a.node, a.feature, a.value)
+}
+
+type moveDependents struct {
+	from string
+	to   string
+}
+
+// MoveDependents returns an action that attaches the dependents of the
+// token bound to 'from' to the token bound to 'to'. If 'to' is a
+// dependent of 'from', it is not moved.
+func MoveDependents(from, to string) Action {
+	return moveDependents{from, to}
+}
+
+func (a moveDependents) Apply(sentence conllx.Sentence, nodes map[string]int) error {
+	from, err := lookup(nodes, a.from)
+	if err != nil {
+		return err
+	}
+
+	to, err := lookup(nodes, a.to)
+	if err != nil {
+		return err
+	}
+
+	for idx := range sentence {
+		if head, ok := sentence[idx].Head(); ok && head == uint(from+1) && idx != to {
+			sentence[idx].SetHead(uint(to + 1))
+		}
+	}
+
+	return nil
+}
+
+func (a moveDependents) nodeNames() []string {
+	return []string{a.from, a.to}
+}
+
+func (a moveDependents) String() string {
+	return fmt.Sprintf("move(%s, %s)", a.from, a.to)
+}
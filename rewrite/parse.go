@@ -0,0 +1,417 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rewrite
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+
+	"gopkg.in/danieldk/conllx.v1"
+	"gopkg.in/danieldk/conllx.v1/query"
+)
+
+// ParseRules reads rules in a line-based format. Every rule has the form
+//
+//	NAME: QUERY => ACTION; ACTION; ...
+//
+// Lines that start with whitespace continue the previous rule, empty
+// lines and lines starting with # are ignored. The actions are:
+//
+//	reattach(NODE, HEAD)           attach NODE to HEAD, which is a node,
+//	                               root, or head(NODE)
+//	relabel(NODE, REL)             change the relation of NODE
+//	set(NODE, LAYER, VALUE)        set a layer, such as postag, of NODE
+//	setfeat(NODE, FEATURE, VALUE)  set a feature of NODE
+//	move(FROM, TO)                 attach the dependents of FROM to TO
+//
+// Arguments can be double-quoted, to use characters such as commas,
+// semicolons, and parentheses in values. Quoted arguments follow the
+// quoting rules of query strings. For example, the following rule makes
+// participles the head of auxiliaries:
+//
+//	promote-aux: [pos=VAFIN]=aux > [pos=VVPP]=main
+//	    => reattach(main, head(aux)); reattach(aux, main); move(aux, main)
+func ParseRules(r io.Reader) ([]*Rule, error) {
+	scanner := bufio.NewScanner(r)
+
+	var rules []*Rule
+	var rule strings.Builder
+	ruleLine := 0
+
+	flush := func() error {
+		if rule.Len() == 0 {
+			return nil
+		}
+
+		parsed, err := parseRule(rule.String())
+		if err != nil {
+			return fmt.Errorf("Line %d: %s", ruleLine, err)
+		}
+
+		rules = append(rules, parsed)
+		rule.Reset()
+
+		return nil
+	}
+
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+
+		if len(trimmed) == 0 || trimmed[0] == '#' {
+			continue
+		}
+
+		if unicode.IsSpace(rune(line[0])) && rule.Len() != 0 {
+			rule.WriteByte(' ')
+			rule.WriteString(trimmed)
+			continue
+		}
+
+		if err := flush(); err != nil {
+			return nil, err
+		}
+
+		rule.WriteString(trimmed)
+		ruleLine = lineNo
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func parseRule(rule string) (*Rule, error) {
+	sepIdx := strings.IndexByte(rule, ':')
+	if sepIdx == -1 {
+		return nil, fmt.Errorf("Rule without name: %s", rule)
+	}
+	name := strings.TrimSpace(rule[:sepIdx])
+	rule = rule[sepIdx+1:]
+
+	arrowIdx, err := findArrow(rule)
+	if err != nil {
+		return nil, fmt.Errorf("Rule %s: %s", name, err)
+	}
+
+	pattern, err := query.Compile(strings.TrimSpace(rule[:arrowIdx]))
+	if err != nil {
+		return nil, fmt.Errorf("Rule %s: %s", name, err)
+	}
+
+	calls, err := parseCalls(rule[arrowIdx+2:])
+	if err != nil {
+		return nil, fmt.Errorf("Rule %s: %s", name, err)
+	}
+
+	var actions []Action
+	for _, c := range calls {
+		action, err := parseAction(c)
+		if err != nil {
+			return nil, fmt.Errorf("Rule %s: %s", name, err)
+		}
+
+		actions = append(actions, action)
+	}
+
+	return NewRule(name, pattern, actions...)
+}
+
+// findArrow returns the index of the first '=>' in a rule that is not
+// part of a string or regular expression of the query.
+func findArrow(rule string) (int, error) {
+	for idx := 0; idx < len(rule); {
+		switch {
+		case rule[idx] == '"' || rule[idx] == '/':
+			_, n, err := query.ScanDelimited(rule[idx:])
+			if err != nil {
+				return 0, err
+			}
+			idx += n
+		case strings.HasPrefix(rule[idx:], "=>"):
+			return idx, nil
+		default:
+			idx++
+		}
+	}
+
+	return 0, errors.New("Missing '=>'")
+}
+
+// A call is an action or head target, such as relabel(n, SBJ).
+type call struct {
+	name string
+	args []argument
+}
+
+// An argument is a value or a nested call.
+type argument struct {
+	value string
+	call  *call
+}
+
+func (a argument) String() string {
+	if a.call != nil {
+		return a.call.name + "(...)"
+	}
+
+	return a.value
+}
+
+// An actionToken is a word, a quoted string, or one of the punctuation
+// characters of actions.
+type actionToken struct {
+	value  string
+	quoted bool
+}
+
+func (t actionToken) is(punct string) bool {
+	return !t.quoted && t.value == punct
+}
+
+func (t actionToken) isPunct() bool {
+	return !t.quoted && len(t.value) == 1 && strings.Contains(actionPunct, t.value)
+}
+
+const actionPunct = "(),;"
+
+// lexActions splits the actions of a rule in tokens. Quoted strings
+// follow the quoting rules of queries.
+func lexActions(actions string) ([]actionToken, error) {
+	var tokens []actionToken
+
+	for idx := 0; idx < len(actions); {
+		c := actions[idx]
+
+		switch {
+		case c == ' ' || c == '\t':
+			idx++
+		case strings.IndexByte(actionPunct, c) != -1:
+			tokens = append(tokens, actionToken{value: string(c)})
+			idx++
+		case c == '"':
+			value, n, err := query.ScanDelimited(actions[idx:])
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, actionToken{value: value, quoted: true})
+			idx += n
+		default:
+			end := idx
+			for end < len(actions) && strings.IndexByte(actionPunct+" \t\"", actions[end]) == -1 {
+				end++
+			}
+			tokens = append(tokens, actionToken{value: actions[idx:end]})
+			idx = end
+		}
+	}
+
+	return tokens, nil
+}
+
+type actionParser struct {
+	tokens []actionToken
+	pos    int
+}
+
+func (p *actionParser) peek() (actionToken, bool) {
+	if p.pos == len(p.tokens) {
+		return actionToken{}, false
+	}
+
+	return p.tokens[p.pos], true
+}
+
+func (p *actionParser) expect(punct string) error {
+	tok, ok := p.peek()
+	if !ok {
+		return fmt.Errorf("Expected '%s', got end of rule", punct)
+	}
+
+	if !tok.is(punct) {
+		return fmt.Errorf("Expected '%s', got: %s", punct, tok.value)
+	}
+
+	p.pos++
+	return nil
+}
+
+// parseCalls parses actions that are separated by semicolons.
+func parseCalls(actions string) ([]*call, error) {
+	tokens, err := lexActions(actions)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &actionParser{tokens: tokens}
+
+	var calls []*call
+	for {
+		tok, ok := p.peek()
+		if !ok {
+			return calls, nil
+		}
+
+		if tok.is(";") {
+			p.pos++
+			continue
+		}
+
+		c, err := p.parseCall()
+		if err != nil {
+			return nil, err
+		}
+		calls = append(calls, c)
+
+		if tok, ok := p.peek(); ok && !tok.is(";") {
+			return nil, fmt.Errorf("Expected ';', got: %s", tok.value)
+		}
+	}
+}
+
+// parseCall parses a call of the form name(arg, ...).
+func (p *actionParser) parseCall() (*call, error) {
+	tok, ok := p.peek()
+	if !ok {
+		return nil, errors.New("Expected NAME(ARGS), got end of rule")
+	}
+
+	if tok.quoted || tok.isPunct() {
+		return nil, fmt.Errorf("Expected NAME(ARGS), got: %s", tok.value)
+	}
+	p.pos++
+
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	c := &call{name: tok.value}
+	for {
+		arg, err := p.parseArgument()
+		if err != nil {
+			return nil, err
+		}
+		c.args = append(c.args, arg)
+
+		if tok, ok := p.peek(); ok && tok.is(",") {
+			p.pos++
+			continue
+		}
+
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+
+		return c, nil
+	}
+}
+
+func (p *actionParser) parseArgument() (argument, error) {
+	tok, ok := p.peek()
+	if !ok {
+		return argument{}, errors.New("Expected argument, got end of rule")
+	}
+
+	if tok.quoted {
+		p.pos++
+		return argument{value: tok.value}, nil
+	}
+
+	if tok.isPunct() {
+		return argument{}, fmt.Errorf("Expected argument, got: %s", tok.value)
+	}
+
+	if p.pos+1 < len(p.tokens) && p.tokens[p.pos+1].is("(") {
+		c, err := p.parseCall()
+		if err != nil {
+			return argument{}, err
+		}
+		return argument{call: c}, nil
+	}
+
+	p.pos++
+	return argument{value: tok.value}, nil
+}
+
+var actionArity = map[string]int{
+	"reattach": 2,
+	"relabel":  2,
+	"set":      3,
+	"setfeat":  3,
+	"move":     2,
+}
+
+func parseAction(c *call) (Action, error) {
+	arity, ok := actionArity[c.name]
+	if !ok {
+		return nil, fmt.Errorf("Unknown action: %s", c.name)
+	}
+
+	if len(c.args) != arity {
+		return nil, fmt.Errorf("Action %s expects %d arguments, got %d", c.name, arity, len(c.args))
+	}
+
+	if c.name == "reattach" {
+		if c.args[0].call != nil {
+			return nil, fmt.Errorf("Expected node, got: %s", c.args[0])
+		}
+
+		head, err := parseTarget(c.args[1])
+		if err != nil {
+			return nil, err
+		}
+		return Reattach(c.args[0].value, head), nil
+	}
+
+	args := make([]string, len(c.args))
+	for idx, arg := range c.args {
+		if arg.call != nil {
+			return nil, fmt.Errorf("Unexpected call in arguments of %s: %s", c.name, arg)
+		}
+		args[idx] = arg.value
+	}
+
+	switch c.name {
+	case "relabel":
+		return Relabel(args[0], args[1]), nil
+	case "set":
+		layer, err := conllx.ParseLayer(args[1])
+		if err != nil {
+			return nil, err
+		}
+		return SetLayer(args[0], layer, args[2]), nil
+	case "setfeat":
+		return SetFeature(args[0], args[1], args[2]), nil
+	default:
+		return MoveDependents(args[0], args[1]), nil
+	}
+}
+
+func parseTarget(target argument) (Target, error) {
+	if target.call != nil {
+		c := target.call
+		if c.name != "head" || len(c.args) != 1 || c.args[0].call != nil {
+			return nil, fmt.Errorf("Unknown head: %s", target)
+		}
+
+		return HeadOf(c.args[0].value), nil
+	}
+
+	if target.value == "root" {
+		return Root(), nil
+	}
+
+	return Node(target.value), nil
+}
@@ -0,0 +1,175 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package rewrite implements rule-based rewriting of dependency trees,
+// for instance to convert between annotation schemes.
+//
+// A rule consists of a query (see the query package) and a sequence of
+// actions that modify the tokens bound to the named nodes of the query.
+// Rules are applied in order. A rule is applied to its first match,
+// after which the sentence is matched again, until there are no
+// matches left to which the rule was not applied yet. This makes
+// rewriting deterministic and guarantees termination.
+package rewrite
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/danieldk/conllx.v1"
+	"gopkg.in/danieldk/conllx.v1/query"
+)
+
+// A Rule rewrites the matches of a pattern.
+type Rule struct {
+	name    string
+	pattern *query.Pattern
+	actions []Action
+}
+
+// NewRule creates a rule. An error is returned when an action refers
+// to a node that is not named in the pattern.
+func NewRule(name string, pattern *query.Pattern, actions ...Action) (*Rule, error) {
+	names := make(map[string]bool)
+	for _, name := range pattern.Names() {
+		names[name] = true
+	}
+
+	for _, action := range actions {
+		referrer, ok := action.(nodeReferrer)
+		if !ok {
+			continue
+		}
+
+		for _, node := range referrer.nodeNames() {
+			if !names[node] {
+				return nil, fmt.Errorf("Rule %s: action %s refers to unknown node: %s", name, action, node)
+			}
+		}
+	}
+
+	return &Rule{
+		name:    name,
+		pattern: pattern,
+		actions: actions,
+	}, nil
+}
+
+// Name returns the name of the rule.
+func (r *Rule) Name() string {
+	return r.name
+}
+
+func (r *Rule) String() string {
+	actions := make([]string, len(r.actions))
+	for idx, action := range r.actions {
+		actions[idx] = action.String()
+	}
+
+	return fmt.Sprintf("%s: %s => %s", r.name, r.pattern, strings.Join(actions, "; "))
+}
+
+// A Firing records the application of a rule.
+type Firing struct {
+	Rule string
+
+	// Nodes maps the named nodes of the rule to the indices (starting
+	// at 0) of the tokens to which the rule was applied.
+	Nodes map[string]int
+}
+
+// A Rewriter applies rules to sentences.
+type Rewriter struct {
+	rules  []*Rule
+	counts map[string]int
+}
+
+// NewRewriter creates a rewriter for the given rules.
+func NewRewriter(rules ...*Rule) *Rewriter {
+	return &Rewriter{
+		rules:  rules,
+		counts: make(map[string]int),
+	}
+}
+
+// Rewrite applies the rules to a sentence in place and returns the
+// firings in order of application. After rewriting, the sentence must
+// still be a tree if it was a tree before rewriting. Otherwise, an error
+// is returned together with the firings.
+func (rw *Rewriter) Rewrite(sentence conllx.Sentence) ([]Firing, error) {
+	wasTree := sentence.ValidateTree() == nil
+
+	var firings []Firing
+
+	for _, rule := range rw.rules {
+		applied := make(map[string]bool)
+
+		for {
+			match, ok := nextMatch(rule, sentence, applied)
+			if !ok {
+				break
+			}
+
+			for _, action := range rule.actions {
+				if err := action.Apply(sentence, match.Names); err != nil {
+					return firings, fmt.Errorf("Rule %s: %s", rule.name, err)
+				}
+			}
+
+			firings = append(firings, Firing{
+				Rule:  rule.name,
+				Nodes: match.Names,
+			})
+
+			rw.counts[rule.name]++
+		}
+	}
+
+	if wasTree {
+		if err := sentence.ValidateTree(); err != nil {
+			return firings, fmt.Errorf("Rewritten sentence is not a tree: %s", err)
+		}
+	}
+
+	return firings, nil
+}
+
+// nextMatch returns the first match of a rule that was not applied yet
+// and marks it as applied.
+func nextMatch(rule *Rule, sentence conllx.Sentence, applied map[string]bool) (query.Match, bool) {
+	for _, match := range rule.pattern.FindAll(sentence) {
+		key := matchKey(match)
+		if !applied[key] {
+			applied[key] = true
+			return match, true
+		}
+	}
+
+	return query.Match{}, false
+}
+
+func matchKey(match query.Match) string {
+	nodes := make([]string, len(match.Nodes))
+	for idx, node := range match.Nodes {
+		nodes[idx] = strconv.Itoa(node)
+	}
+
+	return strings.Join(nodes, " ")
+}
+
+// Counts returns the number of times that each rule fired, across all
+// sentences that were rewritten.
+func (rw *Rewriter) Counts() map[string]int {
+	return rw.counts
+}
+
+// Reader returns a reader that rewrites the sentences of 'reader'.
+// Rewriting errors are returned by ReadSentence.
+func (rw *Rewriter) Reader(reader conllx.SentenceReader) conllx.SentenceReader {
+	return conllx.NewMapReader(reader, func(sentence conllx.Sentence) (conllx.Sentence, error) {
+		_, err := rw.Rewrite(sentence)
+		return sentence, err
+	})
+}
@@ -0,0 +1,172 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rewrite
+
+import (
+	"bufio"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/danieldk/conllx.v1"
+	"gopkg.in/danieldk/conllx.v1/query"
+)
+
+const testFragment string = `1	Er	er	PRO	PPER	case:nom	2	SUBJ
+2	hat	haben	V	VAFIN	_	0	ROOT
+3	es	es	PRO	PPER	case:acc	4	OBJA
+4	gesehen	sehen	V	VVPP	_	2	AUX
+5	.	.	$.	$.	_	2	PUNCT`
+
+const testRules string = `# Make participles the heads of auxiliaries.
+promote-aux: [pos=VAFIN]=aux >AUX [pos=VVPP]=main
+    => reattach(main, head(aux)); reattach(aux, main); relabel(aux, aux); move(aux, main); relabel(main, ROOT)
+
+lowercase-rel: [rel=/[A-Z]+/ & rel!=ROOT]=n => relabel(n, "lower"); setfeat(n, rel, changed)
+`
+
+const testExpected string = `1	Er	er	PRO	PPER	case:nom|rel:changed	4	lower	_	_
+2	hat	haben	V	VAFIN	_	4	aux	_	_
+3	es	es	PRO	PPER	case:acc|rel:changed	4	lower	_	_
+4	gesehen	sehen	V	VVPP	_	0	ROOT	_	_
+5	.	.	$.	$.	rel:changed	4	lower	_	_`
+
+func testSentence(t *testing.T) conllx.Sentence {
+	reader := conllx.NewReader(bufio.NewReader(strings.NewReader(testFragment)))
+	sentence, err := reader.ReadSentence()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	return sentence
+}
+
+func promoteAuxRule(t *testing.T) *Rule {
+	rule, err := NewRule("promote-aux",
+		query.MustCompile("[pos=VAFIN]=aux >AUX [pos=VVPP]=main"),
+		Reattach("main", HeadOf("aux")),
+		Reattach("aux", Node("main")),
+		MoveDependents("aux", "main"),
+		Relabel("aux", "aux"),
+		Relabel("main", "ROOT"),
+		SetLayer("aux", conllx.CoarsePosTagLayer, "AUX"))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	return rule
+}
+
+func TestRewrite(t *testing.T) {
+	sentence := testSentence(t)
+	rw := NewRewriter(promoteAuxRule(t))
+
+	firings, err := rw.Rewrite(sentence)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	expected := []Firing{{Rule: "promote-aux", Nodes: map[string]int{"aux": 1, "main": 3}}}
+	if !reflect.DeepEqual(firings, expected) {
+		t.Fatal("Unexpected firings:", firings)
+	}
+
+	for idx, head := range []uint{4, 4, 4, 0, 4} {
+		if h, _ := sentence[idx].Head(); h != head {
+			t.Fatalf("Token %d should have head %d, has %d", idx+1, head, h)
+		}
+	}
+
+	if cpos, _ := sentence[1].CoarsePosTag(); cpos != "AUX" {
+		t.Fatal("Coarse tag of the auxiliary should be changed, got:", cpos)
+	}
+
+	// The rule does not match anymore.
+	if firings, _ := rw.Rewrite(sentence); len(firings) != 0 {
+		t.Fatal("Rule should not fire on the rewritten sentence")
+	}
+
+	if rw.Counts()["promote-aux"] != 1 {
+		t.Fatal("Unexpected rule counts:", rw.Counts())
+	}
+}
+
+func TestRewriteInvalidTree(t *testing.T) {
+	rule, err := NewRule("cycle", query.MustCompile("[pos=VAFIN]=aux > [pos=VVPP]=main"),
+		Reattach("aux", Node("main")))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if _, err := NewRewriter(rule).Rewrite(testSentence(t)); err == nil {
+		t.Fatal("expected error for a rewrite that introduces a cycle")
+	}
+}
+
+func TestNewRuleUnknownNode(t *testing.T) {
+	_, err := NewRule("unknown", query.MustCompile("[]=a"), Relabel("b", "x"))
+	if err == nil {
+		t.Fatal("expected error for an action on an unknown node")
+	}
+}
+
+func TestParseRules(t *testing.T) {
+	rules, err := ParseRules(strings.NewReader(testRules))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(rules) != 2 || rules[0].Name() != "promote-aux" || rules[1].Name() != "lowercase-rel" {
+		t.Fatal("Unexpected rules:", rules)
+	}
+
+	sentence := testSentence(t)
+	if _, err := NewRewriter(rules...).Rewrite(sentence); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if sentence.String() != testExpected {
+		t.Fatalf("Expected:\n%s\nGot:\n%s", testExpected, sentence)
+	}
+}
+
+func TestParseRulesQuoted(t *testing.T) {
+	rules, err := ParseRules(strings.NewReader(`quoted: [form="=>" | form=/a;b/]=n
+    => relabel(n, "a,b"); set(n, lemma, "a;b"); set(n, postag, "=>"); setfeat(n, "f(x)", "\"y\"")`))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	sentence := conllx.Sentence{*conllx.NewToken().SetForm("=>").SetHead(0)}
+	if _, err := NewRewriter(rules...).Rewrite(sentence); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	expected := "1\t=>\ta;b\t_\t=>\tf(x):\"y\"\t0\ta,b\t_\t_"
+	if sentence.String() != expected {
+		t.Fatalf("Expected:\n%s\nGot:\n%s", expected, sentence)
+	}
+}
+
+func TestParseRulesErrors(t *testing.T) {
+	for _, rules := range []string{
+		"no name",
+		"r: [] relabel(x, y)",
+		"r: [=> relabel(x, y)",
+		"r: []=x => frobnicate(x)",
+		"r: []=x => relabel(x)",
+		"r: []=x => reattach(x, tail(x))",
+		"r: []=x => set(x, head, 1)",
+		"r: []=x => relabel(x, \"y)",
+		"r: []=x => relabel(x, y) relabel(x, z)",
+		"r: []=x => relabel(x, head(x))",
+		"r: [form=\"=>\"]=x",
+		"r: []=x => relabel(y, z)",
+	} {
+		if _, err := ParseRules(strings.NewReader(rules)); err == nil {
+			t.Fatalf("Rules should not parse: %s", rules)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schema
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// An Option configures a Reader or a Writer.
+type Option func(*options)
+
+type options struct {
+	whitespace    bool
+	commentPrefix string
+}
+
+// WhitespaceSeparated configures a reader to split columns on any
+// amount of whitespace, rather than on single tabs. A writer separates
+// columns by a single space.
+func WhitespaceSeparated() Option {
+	return func(o *options) {
+		o.whitespace = true
+	}
+}
+
+// CommentPrefix configures a reader to skip lines that start with
+// 'prefix', such as the #begin document lines of CoNLL-2012 data.
+func CommentPrefix(prefix string) Option {
+	return func(o *options) {
+		o.commentPrefix = prefix
+	}
+}
+
+func newOptions(opts []Option) options {
+	var o options
+	for _, option := range opts {
+		option(&o)
+	}
+
+	return o
+}
+
+// A Reader reads sentences of which the columns are described by a
+// schema. Every line must have exactly one value per column.
+type Reader struct {
+	scanner *bufio.Scanner
+	schema  *Schema
+	options options
+	line    int
+}
+
+// NewReader creates a new reader for the given schema from a buffered
+// I/O reader. The caller is responsible for closing the provided
+// reader.
+func NewReader(r *bufio.Reader, schema *Schema, opts ...Option) *Reader {
+	return &Reader{
+		scanner: bufio.NewScanner(r),
+		schema:  schema,
+		options: newOptions(opts),
+	}
+}
+
+func (r *Reader) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("Line %d: %s", r.line, fmt.Sprintf(format, args...))
+}
+
+// ReadSentence returns the next sentence. If there is no more data
+// that can be read, io.EOF is returned as the error.
+func (r *Reader) ReadSentence() (*Sentence, error) {
+	sentence := &Sentence{schema: r.schema}
+
+	for r.scanner.Scan() {
+		r.line++
+
+		line := r.scanner.Text()
+		if r.options.commentPrefix != "" && strings.HasPrefix(line, r.options.commentPrefix) {
+			continue
+		}
+
+		line = strings.TrimSpace(line)
+		if len(line) == 0 {
+			if len(sentence.rows) == 0 {
+				continue
+			}
+
+			break
+		}
+
+		var values []string
+		if r.options.whitespace {
+			values = strings.Fields(line)
+		} else {
+			values = strings.Split(line, "\t")
+		}
+
+		if len(values) != r.schema.Len() {
+			return nil, r.errorf("Expected %d columns, got %d", r.schema.Len(), len(values))
+		}
+
+		for idx, column := range r.schema.columns {
+			if err := column.validate(values[idx]); err != nil {
+				return nil, r.errorf("%s", err)
+			}
+		}
+
+		sentence.rows = append(sentence.rows, values)
+	}
+
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(sentence.rows) == 0 {
+		return nil, io.EOF
+	}
+
+	return sentence, nil
+}
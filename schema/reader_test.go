@@ -0,0 +1,137 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schema
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testCoNLL2003 = `-DOCSTART- -X- O O
+
+U.N. NNP I-NP I-ORG
+official NN I-NP O
+Ekeus NNP I-NP I-PER
+heads VBZ I-VP O
+
+Peter NNP I-NP B-PER
+Blackburn NNP I-NP I-PER`
+
+const testExtra = "1\tDie\tdie\tART\tART\tcase:nom|num:sg\t2\tDET\t_\t_\tB-NP\t(1\n" +
+	"2\tKatze\tKatze\tN\tNN\t_\t0\tROOT\t_\t_\tI-NP\t1)"
+
+func TestReaderWhitespace(t *testing.T) {
+	reader := NewReader(bufio.NewReader(strings.NewReader(testCoNLL2003)),
+		CoNLL2003(), WhitespaceSeparated(), CommentPrefix("-DOCSTART-"))
+
+	sentence, err := reader.ReadSentence()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	expected := []string{"I-ORG", "O", "I-PER", "O"}
+	if ner := sentence.Values("ner"); !reflect.DeepEqual(ner, expected) {
+		t.Fatalf("Expected %v, got %v", expected, ner)
+	}
+
+	sentence, err = reader.ReadSentence()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if form, ok := sentence.Value(1, "form"); !ok || form != "Blackburn" {
+		t.Fatal("Expected Blackburn, got:", form)
+	}
+
+	if _, err := reader.ReadSentence(); err != io.EOF {
+		t.Fatal("Expected io.EOF, got:", err)
+	}
+}
+
+func TestExtraColumns(t *testing.T) {
+	schema, err := Parse("id:int form lemma cpostag postag feats:feats head:int deprel phead:int pdeprel chunk:span coref:string:-")
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	reader := NewReader(bufio.NewReader(strings.NewReader(testExtra)), schema)
+	sentence, err := reader.ReadSentence()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if coref := sentence.Values("coref"); !reflect.DeepEqual(coref, []string{"(1", "1)"}) {
+		t.Fatal("Unexpected coreference column:", coref)
+	}
+
+	features, ok := sentence.Features(0, "feats")
+	if !ok || !reflect.DeepEqual(features.FeaturesMap(), map[string]string{"case": "nom", "num": "sg"}) {
+		t.Fatal("Unexpected features:", features)
+	}
+
+	tokens := sentence.Tokens()
+	if head, ok := tokens[0].Head(); !ok || head != 2 {
+		t.Fatal("Expected head 2, got:", head)
+	}
+	if tag, _ := tokens[1].PosTag(); tag != "NN" {
+		t.Fatal("Expected tag NN, got:", tag)
+	}
+	if _, ok := tokens[1].Features(); ok {
+		t.Fatal("Absent features should not be set")
+	}
+
+	var buf bytes.Buffer
+	if err := NewWriter(&buf, schema).WriteSentence(sentence); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if buf.String() != testExtra {
+		t.Fatalf("Expected:\n%s\ngot:\n%s", testExtra, buf.String())
+	}
+}
+
+func TestSet(t *testing.T) {
+	sentence := NewSentence(CoNLL2000(), 2)
+
+	if err := sentence.Set(0, "chunk", "B-NP"); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	for _, test := range [][2]string{
+		{"chunk", "NP"},
+		{"form", ""},
+		{"unknown", "x"},
+	} {
+		if err := sentence.Set(1, test[0], test[1]); err == nil {
+			t.Fatalf("Value %q should not be set in column %s", test[1], test[0])
+		}
+	}
+
+	sentence.Unset(0, "chunk")
+	if _, ok := sentence.Value(0, "chunk"); ok {
+		t.Fatal("Unset value should be absent")
+	}
+
+	if err := NewWriter(io.Discard, CoNLL2003()).WriteSentence(sentence); err == nil {
+		t.Fatal("Sentence with a different schema should not be written")
+	}
+}
+
+func TestReaderErrors(t *testing.T) {
+	for _, data := range []string{
+		"Peter\tNNP\tI-NP",
+		"Peter\tNNP\tI-NP\tPER\t",
+		"Peter\tNNP\tI-NP\tI-PER\textra",
+	} {
+		reader := NewReader(bufio.NewReader(strings.NewReader(data)), CoNLL2003())
+		if _, err := reader.ReadSentence(); err == nil || err == io.EOF {
+			t.Fatalf("Expected an error for %q, got: %v", data, err)
+		}
+	}
+}
@@ -0,0 +1,301 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package schema reads and writes tabular CoNLL variants with an
+// arbitrary number of columns, such as the CoNLL-2000 chunking and
+// CoNLL-2003 named entity formats. The columns are declared in a
+// schema and values are accessed by column name.
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A Type is the type of the values of a column.
+type Type int
+
+const (
+	// String columns contain arbitrary strings.
+	String Type = iota
+
+	// Int columns contain non-negative integers.
+	Int
+
+	// Features columns contain features in the CoNLL-X format, such as
+	// case:nom|num:sg.
+	Features
+
+	// Span columns contain span tags, such as B-PER, I-PER, and O.
+	Span
+)
+
+var typeNames = map[Type]string{
+	String:   "string",
+	Int:      "int",
+	Features: "feats",
+	Span:     "span",
+}
+
+// String returns the name of the type.
+func (t Type) String() string {
+	if name, ok := typeNames[t]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("Type(%d)", int(t))
+}
+
+// ParseType parses a type name: string, int, feats, or span.
+func ParseType(name string) (Type, error) {
+	for t, typeName := range typeNames {
+		if typeName == name {
+			return t, nil
+		}
+	}
+
+	return 0, fmt.Errorf("Unknown column type: %s", name)
+}
+
+// DefaultEmpty is the default marker of absent values.
+const DefaultEmpty = "_"
+
+// A Column is a column declaration.
+type Column struct {
+	// Name is the name of the column, which must be unique within a
+	// schema.
+	Name string
+
+	// Type is the type of the values in the column.
+	Type Type
+
+	// Empty is the marker for absent values. If Empty is the empty
+	// string, DefaultEmpty is used.
+	Empty string
+}
+
+func (c Column) empty() string {
+	if c.Empty == "" {
+		return DefaultEmpty
+	}
+
+	return c.Empty
+}
+
+// validate checks that a value that is not the empty marker is valid
+// for the type of the column.
+func (c Column) validate(value string) error {
+	if value == "" {
+		return fmt.Errorf("Empty value in column %s", c.Name)
+	}
+
+	if value == c.empty() {
+		return nil
+	}
+
+	switch c.Type {
+	case Int:
+		if _, err := strconv.ParseUint(value, 10, 32); err != nil {
+			return fmt.Errorf("Invalid integer in column %s: %s", c.Name, value)
+		}
+	case Span:
+		if _, _, err := ParseSpanTag(value); err != nil {
+			return fmt.Errorf("Invalid span tag in column %s: %s", c.Name, value)
+		}
+	}
+
+	return nil
+}
+
+// A Schema is an ordered list of columns.
+type Schema struct {
+	columns []Column
+	index   map[string]int
+}
+
+// New creates a schema from column declarations. An error is returned
+// when there are no columns, or when a column name is empty or not
+// unique.
+func New(columns ...Column) (*Schema, error) {
+	if len(columns) == 0 {
+		return nil, errors.New("Schema does not have columns")
+	}
+
+	index := make(map[string]int)
+	for idx, column := range columns {
+		if column.Name == "" {
+			return nil, fmt.Errorf("Column %d does not have a name", idx+1)
+		}
+
+		if strings.ContainsAny(column.empty(), " \t") {
+			return nil, fmt.Errorf("Empty marker of column %s contains whitespace", column.Name)
+		}
+
+		if _, ok := index[column.Name]; ok {
+			return nil, fmt.Errorf("Duplicate column: %s", column.Name)
+		}
+
+		index[column.Name] = idx
+	}
+
+	return &Schema{
+		columns: append([]Column(nil), columns...),
+		index:   index,
+	}, nil
+}
+
+// MustNew creates a schema like New, but panics when the schema is
+// invalid.
+func MustNew(columns ...Column) *Schema {
+	schema, err := New(columns...)
+	if err != nil {
+		panic("schema: " + err.Error())
+	}
+
+	return schema
+}
+
+// Parse parses a schema from a whitespace-separated list of column
+// declarations of the form NAME[:TYPE[:EMPTY]], such as:
+//
+//	form pos chunk:span ner:span:O
+//
+// The type defaults to string and the empty marker to DefaultEmpty.
+func Parse(spec string) (*Schema, error) {
+	var columns []Column
+
+	for _, decl := range strings.Fields(spec) {
+		parts := strings.SplitN(decl, ":", 3)
+
+		column := Column{Name: parts[0]}
+
+		if len(parts) > 1 {
+			t, err := ParseType(parts[1])
+			if err != nil {
+				return nil, err
+			}
+			column.Type = t
+		}
+
+		if len(parts) > 2 {
+			column.Empty = parts[2]
+		}
+
+		columns = append(columns, column)
+	}
+
+	return New(columns...)
+}
+
+// CoNLLX returns the schema of the CoNLL-X format.
+func CoNLLX() *Schema {
+	return MustNew(
+		Column{Name: "id", Type: Int},
+		Column{Name: "form"},
+		Column{Name: "lemma"},
+		Column{Name: "cpostag"},
+		Column{Name: "postag"},
+		Column{Name: "feats", Type: Features},
+		Column{Name: "head", Type: Int},
+		Column{Name: "deprel"},
+		Column{Name: "phead", Type: Int},
+		Column{Name: "pdeprel"},
+	)
+}
+
+// CoNLL2000 returns the schema of the CoNLL-2000 chunking format.
+func CoNLL2000() *Schema {
+	return MustNew(
+		Column{Name: "form"},
+		Column{Name: "postag"},
+		Column{Name: "chunk", Type: Span},
+	)
+}
+
+// CoNLL2003 returns the schema of the CoNLL-2003 named entity format.
+func CoNLL2003() *Schema {
+	return MustNew(
+		Column{Name: "form"},
+		Column{Name: "postag"},
+		Column{Name: "chunk", Type: Span},
+		Column{Name: "ner", Type: Span},
+	)
+}
+
+// Len returns the number of columns.
+func (s *Schema) Len() int {
+	return len(s.columns)
+}
+
+// Columns returns the columns of the schema.
+func (s *Schema) Columns() []Column {
+	return append([]Column(nil), s.columns...)
+}
+
+// Column returns the column with the given name.
+func (s *Schema) Column(name string) (Column, bool) {
+	idx, ok := s.index[name]
+	if !ok {
+		return Column{}, false
+	}
+
+	return s.columns[idx], true
+}
+
+// Equal returns true if both schemas have the same columns.
+func (s *Schema) Equal(other *Schema) bool {
+	if s == other {
+		return true
+	}
+
+	if len(s.columns) != len(other.columns) {
+		return false
+	}
+
+	for idx, column := range s.columns {
+		otherColumn := other.columns[idx]
+		if column.Name != otherColumn.Name || column.Type != otherColumn.Type ||
+			column.empty() != otherColumn.empty() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String returns the schema in the format that is read by Parse.
+func (s *Schema) String() string {
+	decls := make([]string, len(s.columns))
+	for idx, column := range s.columns {
+		decls[idx] = column.Name + ":" + column.Type.String() + ":" + column.empty()
+	}
+
+	return strings.Join(decls, " ")
+}
+
+// ParseSpanTag splits a span tag into its prefix and label, e.g. B-PER
+// into B and PER. The outside tag O has the prefix O and an empty
+// label. Both B-PER and PER-B are accepted, the prefix must be one of
+// B, I, E, S, L, or U.
+func ParseSpanTag(tag string) (prefix, label string, err error) {
+	if tag == "O" {
+		return "O", "", nil
+	}
+
+	if len(tag) > 2 && tag[1] == '-' && isSpanPrefix(tag[:1]) {
+		return tag[:1], tag[2:], nil
+	}
+
+	if len(tag) > 2 && tag[len(tag)-2] == '-' && isSpanPrefix(tag[len(tag)-1:]) {
+		return tag[len(tag)-1:], tag[:len(tag)-2], nil
+	}
+
+	return "", "", fmt.Errorf("Invalid span tag: %s", tag)
+}
+
+func isSpanPrefix(prefix string) bool {
+	return strings.Contains("BIESLU", prefix)
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schema
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	schema, err := Parse("id:int form feats:feats ner:span:O")
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if s := schema.String(); s != "id:int:_ form:string:_ feats:feats:_ ner:span:O" {
+		t.Fatal("Unexpected schema:", s)
+	}
+
+	reparsed, err := Parse(schema.String())
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if !reparsed.Equal(schema) {
+		t.Fatal("Reparsed schema is not equal:", reparsed)
+	}
+
+	if column, ok := schema.Column("ner"); !ok || column.Type != Span || column.Empty != "O" {
+		t.Fatal("Unexpected column:", column)
+	}
+
+	for _, spec := range []string{
+		"",
+		"form form",
+		"form:float",
+		":int",
+	} {
+		if _, err := Parse(spec); err == nil {
+			t.Fatalf("Schema should not be parsed: %q", spec)
+		}
+	}
+}
+
+func TestParseSpanTag(t *testing.T) {
+	for tag, expected := range map[string][2]string{
+		"O":      {"O", ""},
+		"B-PER":  {"B", "PER"},
+		"I-B-NP": {"I", "B-NP"},
+		"NP-E":   {"E", "NP"},
+	} {
+		prefix, label, err := ParseSpanTag(tag)
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if prefix != expected[0] || label != expected[1] {
+			t.Errorf("Expected %v for %s, got %s %s", expected, tag, prefix, label)
+		}
+	}
+
+	for _, tag := range []string{"PER", "X-PER", "B-", "-"} {
+		if _, _, err := ParseSpanTag(tag); err == nil {
+			t.Errorf("Span tag should not be parsed: %s", tag)
+		}
+	}
+}
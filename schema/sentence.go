@@ -0,0 +1,175 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schema
+
+import (
+	"fmt"
+	"strconv"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+// A Sentence is a sentence with values for the columns of a schema.
+type Sentence struct {
+	schema *Schema
+	rows   [][]string
+}
+
+// NewSentence creates a sentence of 'n' tokens in which all values are
+// absent.
+func NewSentence(schema *Schema, n int) *Sentence {
+	rows := make([][]string, n)
+	for idx := range rows {
+		rows[idx] = make([]string, schema.Len())
+		for col, column := range schema.columns {
+			rows[idx][col] = column.empty()
+		}
+	}
+
+	return &Sentence{
+		schema: schema,
+		rows:   rows,
+	}
+}
+
+// Schema returns the schema of the sentence.
+func (s *Sentence) Schema() *Schema {
+	return s.schema
+}
+
+// Len returns the number of tokens.
+func (s *Sentence) Len() int {
+	return len(s.rows)
+}
+
+// Row returns the values of a token (starting at 0) in the order of the
+// schema's columns. Absent values are represented by their empty
+// markers.
+func (s *Sentence) Row(token int) []string {
+	return append([]string(nil), s.rows[token]...)
+}
+
+// Value returns the value of a column for a token (starting at 0). The
+// value is absent if the column does not exist or if the value is the
+// empty marker of the column.
+func (s *Sentence) Value(token int, name string) (string, bool) {
+	idx, ok := s.schema.index[name]
+	if !ok {
+		return "", false
+	}
+
+	value := s.rows[token][idx]
+	if value == s.schema.columns[idx].empty() {
+		return "", false
+	}
+
+	return value, true
+}
+
+// Int returns the value of an integer column for a token (starting at
+// 0).
+func (s *Sentence) Int(token int, name string) (uint, bool) {
+	value, ok := s.Value(token, name)
+	if !ok {
+		return 0, false
+	}
+
+	// Values are validated when they are read or set.
+	n, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return 0, false
+	}
+
+	return uint(n), true
+}
+
+// Features returns the value of a features column for a token
+// (starting at 0).
+func (s *Sentence) Features(token int, name string) (*conllx.Features, bool) {
+	value, ok := s.Value(token, name)
+	if !ok {
+		return nil, false
+	}
+
+	return conllx.NewFeatures(value), true
+}
+
+// Values returns the values of a column for all tokens. Absent values
+// are returned as empty strings. nil is returned if the column does not
+// exist.
+func (s *Sentence) Values(name string) []string {
+	if _, ok := s.schema.index[name]; !ok {
+		return nil
+	}
+
+	values := make([]string, len(s.rows))
+	for idx := range s.rows {
+		values[idx], _ = s.Value(idx, name)
+	}
+
+	return values
+}
+
+// Set sets the value of a column for a token (starting at 0). An error
+// is returned if the column does not exist or if the value is not
+// valid for the type of the column.
+func (s *Sentence) Set(token int, name, value string) error {
+	idx, ok := s.schema.index[name]
+	if !ok {
+		return fmt.Errorf("Unknown column: %s", name)
+	}
+
+	if err := s.schema.columns[idx].validate(value); err != nil {
+		return err
+	}
+
+	s.rows[token][idx] = value
+
+	return nil
+}
+
+// Unset makes the value of a column for a token (starting at 0)
+// absent.
+func (s *Sentence) Unset(token int, name string) {
+	if idx, ok := s.schema.index[name]; ok {
+		s.rows[token][idx] = s.schema.columns[idx].empty()
+	}
+}
+
+// Tokens converts the sentence to a CoNLL-X sentence. Columns that are
+// named after CoNLL-X layers (see conllx.ParseLayer), and the head and
+// phead columns, are mapped to the corresponding layers. Other columns
+// are not part of the result, but remain accessible in this sentence.
+func (s *Sentence) Tokens() conllx.Sentence {
+	sentence := make(conllx.Sentence, len(s.rows))
+
+	for idx := range s.rows {
+		token := &sentence[idx]
+
+		for _, column := range s.schema.columns {
+			value, ok := s.Value(idx, column.Name)
+			if !ok {
+				continue
+			}
+
+			switch column.Name {
+			case "head":
+				if head, ok := s.Int(idx, column.Name); ok {
+					token.SetHead(head)
+				}
+			case "phead":
+				if head, ok := s.Int(idx, column.Name); ok {
+					token.SetPHead(head)
+				}
+			default:
+				if layer, err := conllx.ParseLayer(column.Name); err == nil {
+					token.SetLayer(layer, value)
+				}
+			}
+		}
+	}
+
+	return sentence
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// A Writer writes sentences of which the columns are described by a
+// schema.
+type Writer struct {
+	first     bool
+	writer    io.Writer
+	schema    *Schema
+	separator string
+}
+
+// NewWriter creates a new writer for the given schema. Columns are
+// separated by tabs, unless the WhitespaceSeparated option is used.
+func NewWriter(w io.Writer, schema *Schema, opts ...Option) *Writer {
+	separator := "\t"
+	if newOptions(opts).whitespace {
+		separator = " "
+	}
+
+	return &Writer{
+		first:     true,
+		writer:    w,
+		schema:    schema,
+		separator: separator,
+	}
+}
+
+// WriteSentence writes a sentence. The sentence must have a schema
+// that is equal to the schema of the writer. Absent values are written
+// as the empty markers of their columns.
+func (w *Writer) WriteSentence(sentence *Sentence) error {
+	if !sentence.schema.Equal(w.schema) {
+		return errors.New("Sentence does not have the schema of the writer")
+	}
+
+	// Sentences are separated by an empty line, without a newline after
+	// the last token of the stream, as in the CoNLL-X writer.
+	if w.first {
+		w.first = false
+	} else {
+		fmt.Fprint(w.writer, "\n\n")
+	}
+
+	for idx, row := range sentence.rows {
+		line := strings.Join(row, w.separator)
+		if idx != len(sentence.rows)-1 {
+			line += "\n"
+		}
+
+		if _, err := io.WriteString(w.writer, line); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
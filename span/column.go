@@ -0,0 +1,93 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package span
+
+import (
+	"fmt"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+// A Column is the token column that stores span tags: either an
+// annotation layer or an extra column (see conllx.Token.ExtraColumns).
+type Column struct {
+	layer conllx.Layer
+	extra int
+}
+
+// LayerColumn returns the column for an annotation layer.
+func LayerColumn(layer conllx.Layer) Column {
+	return Column{layer: layer, extra: -1}
+}
+
+// ExtraColumn returns the column for an extra column, where index 0 is
+// the eleventh column.
+func ExtraColumn(idx int) Column {
+	return Column{extra: idx}
+}
+
+// String returns the name of the column.
+func (c Column) String() string {
+	if c.extra >= 0 {
+		return fmt.Sprintf("extra column %d", c.extra+11)
+	}
+
+	return c.layer.String()
+}
+
+// Tags returns the tags of the column. Absent tags are returned as O.
+func (c Column) Tags(sentence conllx.Sentence) []string {
+	tags := make([]string, len(sentence))
+	for idx := range sentence {
+		var tag string
+		var ok bool
+		if c.extra >= 0 {
+			tag, ok = sentence[idx].ExtraColumn(c.extra)
+		} else {
+			tag, ok = sentence[idx].Layer(c.layer)
+		}
+
+		if !ok {
+			tag = "O"
+		}
+
+		tags[idx] = tag
+	}
+
+	return tags
+}
+
+// SetTags stores tags in the column. The number of tags must be equal
+// to the length of the sentence.
+func (c Column) SetTags(sentence conllx.Sentence, tags []string) error {
+	if len(tags) != len(sentence) {
+		return fmt.Errorf("Sentence has %d tokens, got %d tags", len(sentence), len(tags))
+	}
+
+	for idx, tag := range tags {
+		if c.extra >= 0 {
+			sentence[idx].SetExtraColumn(c.extra, tag)
+		} else {
+			sentence[idx].SetLayer(c.layer, tag)
+		}
+	}
+
+	return nil
+}
+
+// Spans decodes the spans in the column of a sentence.
+func (c Column) Spans(sentence conllx.Sentence, scheme Scheme) ([]Span, error) {
+	return Decode(c.Tags(sentence), scheme)
+}
+
+// SetSpans encodes spans as tags in the column of a sentence.
+func (c Column) SetSpans(sentence conllx.Sentence, spans []Span, scheme Scheme) error {
+	tags, err := Encode(spans, len(sentence), scheme)
+	if err != nil {
+		return err
+	}
+
+	return c.SetTags(sentence, tags)
+}
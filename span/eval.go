@@ -0,0 +1,151 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package span
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+// Counts are span counts for computing precision, recall, and F1.
+type Counts struct {
+	Correct   int
+	Predicted int
+	Gold      int
+}
+
+// Precision returns the fraction of predicted spans that are correct.
+func (c Counts) Precision() float64 {
+	if c.Predicted == 0 {
+		return 0
+	}
+
+	return float64(c.Correct) / float64(c.Predicted)
+}
+
+// Recall returns the fraction of gold spans that are predicted.
+func (c Counts) Recall() float64 {
+	if c.Gold == 0 {
+		return 0
+	}
+
+	return float64(c.Correct) / float64(c.Gold)
+}
+
+// F1 returns the harmonic mean of precision and recall.
+func (c Counts) F1() float64 {
+	p, r := c.Precision(), c.Recall()
+	if p+r == 0 {
+		return 0
+	}
+
+	return 2 * p * r / (p + r)
+}
+
+// An Evaluation holds the span counts over all labels and per label. A
+// predicted span is correct when a gold span has the same start, end,
+// and label.
+type Evaluation struct {
+	Counts
+	Labels map[string]*Counts
+}
+
+// NewEvaluation creates an empty evaluation.
+func NewEvaluation() *Evaluation {
+	return &Evaluation{
+		Labels: make(map[string]*Counts),
+	}
+}
+
+// Add adds the spans of a sentence.
+func (e *Evaluation) Add(gold, predicted []Span) {
+	goldSet := make(map[Span]bool)
+	for _, span := range gold {
+		goldSet[span] = true
+		e.Gold++
+		e.label(span.Label).Gold++
+	}
+
+	for _, span := range predicted {
+		e.Predicted++
+		e.label(span.Label).Predicted++
+
+		if goldSet[span] {
+			e.Correct++
+			e.label(span.Label).Correct++
+		}
+	}
+}
+
+func (e *Evaluation) label(label string) *Counts {
+	counts, ok := e.Labels[label]
+	if !ok {
+		counts = &Counts{}
+		e.Labels[label] = counts
+	}
+
+	return counts
+}
+
+// SortedLabels returns the labels in lexicographic order.
+func (e *Evaluation) SortedLabels() []string {
+	labels := make([]string, 0, len(e.Labels))
+	for label := range e.Labels {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+
+	return labels
+}
+
+// Evaluate computes span precision, recall, and F1 of the sentences of
+// 'predicted' against the sentences of 'gold'. The spans are decoded
+// from the same column in both readers. An error is returned when the
+// readers have a different number of sentences or when sentences have
+// different lengths.
+func Evaluate(gold, predicted conllx.SentenceReader, column Column, scheme Scheme) (*Evaluation, error) {
+	eval := NewEvaluation()
+
+	for n := 1; ; n++ {
+		goldSentence, goldErr := gold.ReadSentence()
+		if goldErr != nil && goldErr != io.EOF {
+			return nil, goldErr
+		}
+
+		predSentence, predErr := predicted.ReadSentence()
+		if predErr != nil && predErr != io.EOF {
+			return nil, predErr
+		}
+
+		if goldErr == io.EOF && predErr == io.EOF {
+			return eval, nil
+		}
+
+		if goldErr == io.EOF || predErr == io.EOF {
+			return nil, errors.New("Gold and predicted data have a different number of sentences")
+		}
+
+		if len(goldSentence) != len(predSentence) {
+			return nil, fmt.Errorf("Sentence %d: gold has %d tokens, predicted has %d tokens",
+				n, len(goldSentence), len(predSentence))
+		}
+
+		goldSpans, err := column.Spans(goldSentence, scheme)
+		if err != nil {
+			return nil, fmt.Errorf("Sentence %d: %s", n, err)
+		}
+
+		predSpans, err := column.Spans(predSentence, scheme)
+		if err != nil {
+			return nil, fmt.Errorf("Sentence %d: %s", n, err)
+		}
+
+		eval.Add(goldSpans, predSpans)
+	}
+}
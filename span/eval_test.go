@@ -0,0 +1,92 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package span
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+func taggedSentence(tags ...string) conllx.Sentence {
+	sentence := make(conllx.Sentence, len(tags))
+	for idx, tag := range tags {
+		sentence[idx].SetForm("w").SetExtraColumn(0, tag)
+	}
+
+	return sentence
+}
+
+func TestColumn(t *testing.T) {
+	sentence := taggedSentence("B-PER", "I-PER", "_")
+
+	spans, err := ExtraColumn(0).Spans(sentence, BIO)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if !reflect.DeepEqual(spans, []Span{{0, 2, "PER"}}) {
+		t.Fatal("Unexpected spans:", spans)
+	}
+
+	column := LayerColumn(conllx.PosTagLayer)
+	if err := column.SetSpans(sentence, spans, BIOES); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if tags := column.Tags(sentence); !reflect.DeepEqual(tags, []string{"B-PER", "E-PER", "O"}) {
+		t.Fatal("Unexpected tags:", tags)
+	}
+
+	if err := column.SetTags(sentence, []string{"O"}); err == nil {
+		t.Fatal("Tags of the wrong length should not be set")
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	gold := conllx.NewSliceReader([]conllx.Sentence{
+		taggedSentence("B-PER", "I-PER", "O", "B-LOC"),
+		taggedSentence("B-ORG", "O"),
+	})
+	predicted := conllx.NewSliceReader([]conllx.Sentence{
+		taggedSentence("B-PER", "I-PER", "O", "B-ORG"),
+		taggedSentence("B-ORG", "B-LOC"),
+	})
+
+	eval, err := Evaluate(gold, predicted, ExtraColumn(0), BIO)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if eval.Counts != (Counts{Correct: 2, Predicted: 4, Gold: 3}) {
+		t.Fatal("Unexpected counts:", eval.Counts)
+	}
+
+	if math.Abs(eval.F1()-4.0/7.0) > 1e-9 {
+		t.Fatal("Unexpected F1:", eval.F1())
+	}
+
+	if labels := eval.SortedLabels(); !reflect.DeepEqual(labels, []string{"LOC", "ORG", "PER"}) {
+		t.Fatal("Unexpected labels:", labels)
+	}
+
+	if *eval.Labels["LOC"] != (Counts{Correct: 0, Predicted: 1, Gold: 1}) {
+		t.Fatal("Unexpected LOC counts:", *eval.Labels["LOC"])
+	}
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	for _, predicted := range [][]conllx.Sentence{
+		{taggedSentence("O")},
+		{taggedSentence("O", "O"), taggedSentence("O")},
+	} {
+		gold := conllx.NewSliceReader([]conllx.Sentence{taggedSentence("O", "O")})
+		if _, err := Evaluate(gold, conllx.NewSliceReader(predicted), ExtraColumn(0), BIO); err == nil {
+			t.Errorf("Evaluation should fail for: %v", predicted)
+		}
+	}
+}
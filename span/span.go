@@ -0,0 +1,272 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package span converts between labeled spans, such as named entities
+// or chunks, and per-token tags in the BIO, BIOES, and IOB1 schemes.
+package span
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gopkg.in/danieldk/conllx.v1/schema"
+)
+
+// A Span is a labeled sequence of tokens.
+type Span struct {
+	// Start is the index of the first token, starting at 0.
+	Start int
+
+	// End is the index after the last token.
+	End int
+
+	Label string
+}
+
+// String returns the span as LABEL[START,END).
+func (s Span) String() string {
+	return fmt.Sprintf("%s[%d,%d)", s.Label, s.Start, s.End)
+}
+
+// A Scheme is a tagging scheme for spans.
+type Scheme int
+
+const (
+	// BIO (also known as IOB2) marks the first token of a span with B-
+	// and the other tokens with I-.
+	BIO Scheme = iota
+
+	// BIOES marks the first token of a span with B-, the last token
+	// with E-, the other tokens with I-, and spans of a single token
+	// with S-.
+	BIOES
+
+	// IOB1 marks the tokens of a span with I-. B- is only used for the
+	// first token of a span that directly follows a span with the same
+	// label.
+	IOB1
+)
+
+var schemeNames = map[Scheme]string{
+	BIO:   "bio",
+	BIOES: "bioes",
+	IOB1:  "iob1",
+}
+
+// String returns the name of the scheme.
+func (s Scheme) String() string {
+	if name, ok := schemeNames[s]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("Scheme(%d)", int(s))
+}
+
+// ParseScheme parses a scheme name: bio (or iob2), bioes, or iob1.
+func ParseScheme(name string) (Scheme, error) {
+	name = strings.ToLower(name)
+	if name == "iob2" {
+		return BIO, nil
+	}
+
+	for scheme, schemeName := range schemeNames {
+		if name == schemeName {
+			return scheme, nil
+		}
+	}
+
+	return 0, fmt.Errorf("Unknown tagging scheme: %s", name)
+}
+
+// prefixes returns the prefixes that are used by a scheme, besides the
+// outside tag O.
+func (s Scheme) prefixes() string {
+	if s == BIOES {
+		return "BIES"
+	}
+
+	return "BI"
+}
+
+// parseTag splits a tag into a prefix and label, checking that the
+// prefix is used by the scheme.
+func (s Scheme) parseTag(idx int, tag string) (string, string, error) {
+	prefix, label, err := schema.ParseSpanTag(tag)
+	if err != nil || (prefix != "O" && !strings.Contains(s.prefixes(), prefix)) {
+		return "", "", fmt.Errorf("Token %d: invalid %s tag: %s", idx+1, s, tag)
+	}
+
+	return prefix, label, nil
+}
+
+// Encode converts spans to tags for a sequence of 'n' tokens. Tokens
+// outside spans are tagged O. An error is returned when spans are
+// empty, out of bounds, or overlap.
+func Encode(spans []Span, n int, scheme Scheme) ([]string, error) {
+	tags := make([]string, n)
+	for idx := range tags {
+		tags[idx] = "O"
+	}
+
+	// Label of the span that ends at a token, used for IOB1.
+	ends := make(map[int]string)
+
+	for _, span := range spans {
+		if span.Start < 0 || span.End > n || span.Start >= span.End {
+			return nil, fmt.Errorf("Invalid span: %s", span)
+		}
+
+		for idx := span.Start; idx < span.End; idx++ {
+			if tags[idx] != "O" {
+				return nil, fmt.Errorf("Overlapping span: %s", span)
+			}
+			tags[idx] = "I-" + span.Label
+		}
+
+		switch scheme {
+		case BIO:
+			tags[span.Start] = "B-" + span.Label
+		case BIOES:
+			if span.End-span.Start == 1 {
+				tags[span.Start] = "S-" + span.Label
+			} else {
+				tags[span.Start] = "B-" + span.Label
+				tags[span.End-1] = "E-" + span.Label
+			}
+		}
+
+		ends[span.End-1] = span.Label
+	}
+
+	if scheme == IOB1 {
+		for _, span := range spans {
+			if label, ok := ends[span.Start-1]; ok && label == span.Label {
+				tags[span.Start] = "B-" + span.Label
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+// Decode converts tags to spans. Ill-formed sequences are decoded
+// leniently, following the conventions of the conlleval script: a tag
+// that cannot continue the current span (such as I-PER after O) starts
+// a new span. An error is returned when a tag cannot be parsed or uses
+// a prefix that is not part of the scheme.
+func Decode(tags []string, scheme Scheme) ([]Span, error) {
+	var spans []Span
+	var current *Span
+
+	closeSpan := func(end int) {
+		if current != nil {
+			current.End = end
+			spans = append(spans, *current)
+			current = nil
+		}
+	}
+
+	for idx, tag := range tags {
+		prefix, label, err := scheme.parseTag(idx, tag)
+		if err != nil {
+			return nil, err
+		}
+
+		continues := current != nil && current.Label == label &&
+			(prefix == "I" || prefix == "E")
+
+		switch {
+		case prefix == "O":
+			closeSpan(idx)
+		case continues:
+		default:
+			closeSpan(idx)
+			current = &Span{Start: idx, Label: label}
+		}
+
+		if prefix == "E" || prefix == "S" {
+			closeSpan(idx + 1)
+		}
+	}
+
+	closeSpan(len(tags))
+
+	return spans, nil
+}
+
+// Validate checks that tags form a well-formed sequence in the given
+// scheme. For example, I-PER after O is ill-formed in BIO, but it is
+// well-formed in IOB1.
+func Validate(tags []string, scheme Scheme) error {
+	prevPrefix, prevLabel := "O", ""
+
+	for idx, tag := range tags {
+		prefix, label, err := scheme.parseTag(idx, tag)
+		if err != nil {
+			return err
+		}
+
+		// Whether the previous tag leaves a span open that can be
+		// continued.
+		open := prevPrefix == "B" || prevPrefix == "I"
+
+		var valid bool
+		switch scheme {
+		case BIO:
+			valid = prefix != "I" || (open && prevLabel == label)
+		case IOB1:
+			valid = prefix != "B" || (open && prevLabel == label)
+		case BIOES:
+			if prefix == "I" || prefix == "E" {
+				valid = open && prevLabel == label
+			} else {
+				valid = !open
+			}
+		}
+
+		if !valid {
+			return fmt.Errorf("Token %d: %s cannot follow %s in %s", idx+1, tag, tagString(prevPrefix, prevLabel), scheme)
+		}
+
+		prevPrefix, prevLabel = prefix, label
+	}
+
+	if scheme == BIOES && (prevPrefix == "B" || prevPrefix == "I") {
+		return errors.New("Sequence ends in an unfinished span")
+	}
+
+	return nil
+}
+
+func tagString(prefix, label string) string {
+	if prefix == "O" {
+		return "O"
+	}
+
+	return prefix + "-" + label
+}
+
+// Repair converts an ill-formed tag sequence to a well-formed sequence
+// in the same scheme, by decoding the tags leniently (see Decode) and
+// encoding the resulting spans.
+func Repair(tags []string, scheme Scheme) ([]string, error) {
+	spans, err := Decode(tags, scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	return Encode(spans, len(tags), scheme)
+}
+
+// Convert converts tags from one scheme to another. The tags are
+// decoded leniently (see Decode).
+func Convert(tags []string, from, to Scheme) ([]string, error) {
+	spans, err := Decode(tags, from)
+	if err != nil {
+		return nil, err
+	}
+
+	return Encode(spans, len(tags), to)
+}
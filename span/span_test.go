@@ -0,0 +1,133 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package span
+
+import (
+	"reflect"
+	"testing"
+)
+
+var testSpans = []Span{{0, 2, "PER"}, {2, 3, "PER"}, {4, 5, "LOC"}}
+
+var testTags = map[Scheme][]string{
+	BIO:   {"B-PER", "I-PER", "B-PER", "O", "B-LOC"},
+	BIOES: {"B-PER", "E-PER", "S-PER", "O", "S-LOC"},
+	IOB1:  {"I-PER", "I-PER", "B-PER", "O", "I-LOC"},
+}
+
+func TestEncodeDecode(t *testing.T) {
+	for scheme, expected := range testTags {
+		tags, err := Encode(testSpans, 5, scheme)
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if !reflect.DeepEqual(tags, expected) {
+			t.Errorf("Expected %s tags %v, got %v", scheme, expected, tags)
+		}
+
+		if err := Validate(tags, scheme); err != nil {
+			t.Errorf("Encoded %s tags should be valid: %s", scheme, err)
+		}
+
+		spans, err := Decode(tags, scheme)
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if !reflect.DeepEqual(spans, testSpans) {
+			t.Errorf("Expected %s spans %v, got %v", scheme, testSpans, spans)
+		}
+	}
+}
+
+func TestEncodeErrors(t *testing.T) {
+	for _, spans := range [][]Span{
+		{{0, 0, "PER"}},
+		{{4, 6, "PER"}},
+		{{0, 2, "PER"}, {1, 3, "LOC"}},
+	} {
+		if _, err := Encode(spans, 5, BIO); err == nil {
+			t.Errorf("Spans should not be encoded: %v", spans)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, test := range []struct {
+		scheme Scheme
+		tags   []string
+		valid  bool
+	}{
+		{BIO, []string{"O", "I-PER"}, false},
+		{BIO, []string{"B-LOC", "I-PER"}, false},
+		{BIO, []string{"B-PER", "E-PER"}, false},
+		{IOB1, []string{"O", "I-PER"}, true},
+		{IOB1, []string{"O", "B-PER"}, false},
+		{IOB1, []string{"I-LOC", "B-PER"}, false},
+		{BIOES, []string{"B-PER", "O"}, false},
+		{BIOES, []string{"B-PER"}, false},
+		{BIOES, []string{"I-PER", "E-PER"}, false},
+		{BIOES, []string{"S-PER", "E-PER"}, false},
+		{BIOES, []string{"B-PER", "I-PER", "E-PER", "S-LOC"}, true},
+		{BIOES, []string{"PER"}, false},
+	} {
+		err := Validate(test.tags, test.scheme)
+		if test.valid && err != nil {
+			t.Errorf("%s tags %v should be valid: %s", test.scheme, test.tags, err)
+		} else if !test.valid && err == nil {
+			t.Errorf("%s tags %v should be invalid", test.scheme, test.tags)
+		}
+	}
+}
+
+func TestRepair(t *testing.T) {
+	for _, test := range []struct {
+		scheme   Scheme
+		tags     []string
+		expected []string
+	}{
+		{BIO, []string{"O", "I-PER", "I-LOC", "I-LOC"}, []string{"O", "B-PER", "B-LOC", "I-LOC"}},
+		{BIOES, []string{"B-PER", "O", "E-LOC"}, []string{"S-PER", "O", "S-LOC"}},
+		{IOB1, []string{"B-PER", "I-PER", "O"}, []string{"I-PER", "I-PER", "O"}},
+	} {
+		repaired, err := Repair(test.tags, test.scheme)
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if !reflect.DeepEqual(repaired, test.expected) {
+			t.Errorf("Expected %v, got %v", test.expected, repaired)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	tags, err := Convert(testTags[IOB1], IOB1, BIOES)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if !reflect.DeepEqual(tags, testTags[BIOES]) {
+		t.Fatalf("Expected %v, got %v", testTags[BIOES], tags)
+	}
+}
+
+func TestParseScheme(t *testing.T) {
+	for name, expected := range map[string]Scheme{
+		"bio":   BIO,
+		"IOB2":  BIO,
+		"bioes": BIOES,
+		"iob1":  IOB1,
+	} {
+		if scheme, err := ParseScheme(name); err != nil || scheme != expected {
+			t.Errorf("Expected %s for %s, got %s (%v)", expected, name, scheme, err)
+		}
+	}
+
+	if _, err := ParseScheme("bilou"); err == nil {
+		t.Error("Unknown scheme should not be parsed")
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conllx
+
+import (
+	"errors"
+	"io"
+)
+
+// WriteFolds splits a corpus into folds for cross-validation in a single
+// pass. 'train' and 'test' contain a writer per fold. A sentence that is
+// assigned to fold i is written to test[i] and to the training writers
+// of all other folds. If 'assigner' is nil, sentences are assigned
+// round-robin.
+func WriteFolds(reader SentenceReader, assigner FoldAssigner, train, test []SentenceWriter) error {
+	nFolds := len(train)
+	if nFolds < 1 {
+		return errors.New("The data should be 'splitted' in at least 1 fold.")
+	}
+
+	if len(test) != nFolds {
+		return errors.New("The number of train and test writers should be equal.")
+	}
+
+	if assigner == nil {
+		assigner = NewRoundRobinAssigner()
+	}
+
+	for {
+		sentence, err := reader.ReadSentence()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		fold := assigner.AssignFold(sentence, nFolds)
+
+		for idx := 0; idx < nFolds; idx++ {
+			w := train[idx]
+			if idx == fold {
+				w = test[idx]
+			}
+
+			if err := w.WriteSentence(sentence); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SplitCounts splits a corpus into consecutive parts, such as training,
+// development, and test data. The first counts[0] sentences are written
+// to writers[0], the next counts[1] sentences to writers[1], etc. A
+// negative count can be used for the last part to write all remaining
+// sentences to the last writer. Otherwise, sentences after the last part
+// are not written.
+func SplitCounts(reader SentenceReader, counts []int, writers []SentenceWriter) error {
+	if len(counts) != len(writers) {
+		return errors.New("The number of counts and writers should be equal.")
+	}
+
+	for idx, count := range counts {
+		if count < 0 && idx != len(counts)-1 {
+			return errors.New("Only the last part can have a negative count.")
+		}
+	}
+
+	for idx, count := range counts {
+		for n := 0; count < 0 || n < count; n++ {
+			sentence, err := reader.ReadSentence()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			if err := writers[idx].WriteSentence(sentence); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// SplitRatios splits a corpus into consecutive parts, where the sizes of
+// the parts are proportional to 'ratios'. For instance, the ratios 8, 1,
+// and 1 give a 80%/10%/10% train/development/test split. Sentences that
+// remain due to rounding are added to the last part.
+//
+// Since the corpus size has to be known, the corpus is read into memory
+// before the parts are written.
+func SplitRatios(reader SentenceReader, ratios []float64, writers []SentenceWriter) error {
+	if len(ratios) != len(writers) {
+		return errors.New("The number of ratios and writers should be equal.")
+	}
+
+	var sum float64
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return errors.New("Split ratios should not be negative.")
+		}
+		sum += ratio
+	}
+
+	if sum == 0 {
+		return errors.New("At least one split ratio should be positive.")
+	}
+
+	var sentences []Sentence
+	for {
+		sentence, err := reader.ReadSentence()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		sentences = append(sentences, sentence.Clone())
+	}
+
+	counts := make([]int, len(ratios))
+	for idx, ratio := range ratios[:len(ratios)-1] {
+		counts[idx] = int(ratio / sum * float64(len(sentences)))
+	}
+	counts[len(counts)-1] = -1
+
+	return SplitCounts(NewSliceReader(sentences), counts, writers)
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conllx
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+// sliceWriter collects clones of the sentences that are written.
+type sliceWriter struct {
+	sentences []Sentence
+}
+
+func (w *sliceWriter) WriteSentence(sentence Sentence) error {
+	w.sentences = append(w.sentences, sentence.Clone())
+	return nil
+}
+
+func newSliceWriters(n int) ([]*sliceWriter, []SentenceWriter) {
+	collectors := make([]*sliceWriter, n)
+	writers := make([]SentenceWriter, n)
+	for idx := range collectors {
+		collectors[idx] = &sliceWriter{}
+		writers[idx] = collectors[idx]
+	}
+
+	return collectors, writers
+}
+
+func TestWriteFolds(t *testing.T) {
+	train, trainWriters := newSliceWriters(3)
+	test, testWriters := newSliceWriters(3)
+
+	if err := WriteFolds(stringReader(splitTestFragment), nil, trainWriters, testWriters); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	for fold := 0; fold < 3; fold++ {
+		expectedTest := collectReader(t, mustSplittingReader(t, fold, false))
+		expectedTrain := collectReader(t, mustSplittingReader(t, fold, true))
+
+		if !reflect.DeepEqual(expectedTest, test[fold].sentences) {
+			t.Fatalf("Fold %d, incorrect test data: %v", fold, test[fold].sentences)
+		}
+
+		if !reflect.DeepEqual(expectedTrain, train[fold].sentences) {
+			t.Fatalf("Fold %d, incorrect train data: %v", fold, train[fold].sentences)
+		}
+	}
+}
+
+func TestWriteFoldsMismatch(t *testing.T) {
+	_, trainWriters := newSliceWriters(3)
+	_, testWriters := newSliceWriters(2)
+
+	if err := WriteFolds(stringReader(splitTestFragment), nil, trainWriters, testWriters); err == nil {
+		t.Fatal("expected error when the number of train and test writers differ")
+	}
+}
+
+func TestSplitCounts(t *testing.T) {
+	parts, writers := newSliceWriters(3)
+
+	if err := SplitCounts(stringReader(splitTestFragment), []int{2, 1, -1}, writers); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	expected := [][]Sentence{
+		{splitTestSent0, splitTestSent1},
+		{splitTestSent2},
+		{splitTestSent3, splitTestSent4},
+	}
+
+	for idx, part := range parts {
+		if !reflect.DeepEqual(expected[idx], part.sentences) {
+			t.Fatalf("Part %d, expected: %v, got: %v", idx, expected[idx], part.sentences)
+		}
+	}
+
+	if err := SplitCounts(stringReader(splitTestFragment), []int{-1, 2}, writers[:2]); err == nil {
+		t.Fatal("expected error for a negative count that is not last")
+	}
+}
+
+func TestSplitRatios(t *testing.T) {
+	parts, writers := newSliceWriters(3)
+
+	if err := SplitRatios(NewSliceReader(foldTestSentences), []float64{0.5, 0.25, 0.25}, writers); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	for idx, expectedLen := range []int{4, 2, 2} {
+		if len(parts[idx].sentences) != expectedLen {
+			t.Fatalf("Part %d should have %d sentences, has %d", idx, expectedLen, len(parts[idx].sentences))
+		}
+	}
+
+	if !reflect.DeepEqual(foldTestSentences[6:], parts[2].sentences) {
+		t.Fatal("Last part should contain the last sentences")
+	}
+}
+
+func mustSplittingReader(t *testing.T, fold int, complement bool) SentenceReader {
+	folds := FoldSet{}
+	for idx := 0; idx < 3; idx++ {
+		if (idx == fold) != complement {
+			folds[idx] = nil
+		}
+	}
+
+	r, err := NewSplittingReader(stringReader(splitTestFragment), 3, folds)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	return r
+}
+
+func collectReader(t *testing.T, r SentenceReader) []Sentence {
+	var sentences []Sentence
+	for sentence, err := range Sentences(context.Background(), r) {
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+		sentences = append(sentences, sentence)
+	}
+
+	return sentences
+}
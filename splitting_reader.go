@@ -1,4 +1,4 @@
-// Copyright 2015 The conllx Authors. All rights reserved.
+// Copyright 2015, 2026 The conllx Authors. All rights reserved.
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
@@ -12,28 +12,46 @@ type FoldSet map[int]interface{}
 
 var _ SentenceReader = &SplittingReader{}
 
-// SplittingReader is a wrapper around a (CoNLL-X) Reader that splits the
+// SplittingReader is a wrapper around a SentenceReader that splits the
 // corpus into folds.
 type SplittingReader struct {
-	reader *Reader
-	nFolds int
-	folds  FoldSet
-	count  int
+	reader   SentenceReader
+	nFolds   int
+	folds    FoldSet
+	assigner FoldAssigner
+}
+
+// A SplittingOption configures a SplittingReader.
+type SplittingOption func(*SplittingReader)
+
+// FoldAssignment configures a SplittingReader to assign sentences to
+// folds using 'assigner'. By default, sentences are assigned
+// round-robin by their position in the corpus.
+func FoldAssignment(assigner FoldAssigner) SplittingOption {
+	return func(r *SplittingReader) {
+		r.assigner = assigner
+	}
 }
 
 // NewSplittingReader creates a SplittingReader, that splits the data in
 // 'nFolds' folds. The reader returns the sentences that are in 'folds'.
-func NewSplittingReader(reader *Reader, nFolds int, folds FoldSet) (*SplittingReader, error) {
+func NewSplittingReader(reader SentenceReader, nFolds int, folds FoldSet, options ...SplittingOption) (*SplittingReader, error) {
 	if nFolds < 1 {
 		return nil, errors.New("The data should be 'splitted' in at least 1 fold.")
 	}
 
-	return &SplittingReader{
-		reader: reader,
-		nFolds: nFolds,
-		folds:  folds,
-		count:  -1,
-	}, nil
+	splittingReader := &SplittingReader{
+		reader:   reader,
+		nFolds:   nFolds,
+		folds:    folds,
+		assigner: NewRoundRobinAssigner(),
+	}
+
+	for _, option := range options {
+		option(splittingReader)
+	}
+
+	return splittingReader, nil
 }
 
 // ReadSentence returns the next sentence that is in one of the folds
@@ -45,13 +63,9 @@ func (r *SplittingReader) ReadSentence() (sentence Sentence, err error) {
 			return sentence, err
 		}
 
-		r.count++
-
-		if r.count == r.nFolds {
-			r.count = 0
-		}
+		fold := r.assigner.AssignFold(sentence, r.nFolds)
 
-		if _, ok := r.folds[r.count]; ok {
+		if _, ok := r.folds[fold]; ok {
 			return sentence, nil
 		}
 	}
@@ -0,0 +1,202 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package stats collects corpus statistics from CoNLL-X sentences.
+package stats
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+// Statistics of a corpus. The frequency maps count how often each
+// value of an annotation layer occurs, tokens that do not have the
+// layer are not counted.
+type Statistics struct {
+	Sentences int
+	Tokens    int
+
+	Forms         map[string]int
+	Lemmas        map[string]int
+	CoarsePosTags map[string]int
+	PosTags       map[string]int
+	Relations     map[string]int
+
+	// SentenceLengths maps a sentence length to its frequency.
+	SentenceLengths map[int]int
+
+	// DependencyLengths maps the distance between a token and its head
+	// to its frequency. Attachments to the root are not counted.
+	DependencyLengths map[int]int
+
+	// Trees is the number of sentences that are well-formed dependency
+	// trees, see conllx.Sentence.ValidateTree. Projectivity is only
+	// computed for these sentences.
+	Trees                  int
+	NonProjectiveSentences int
+	NonProjectiveArcs      int
+}
+
+// New creates empty statistics.
+func New() *Statistics {
+	return &Statistics{
+		Forms:             make(map[string]int),
+		Lemmas:            make(map[string]int),
+		CoarsePosTags:     make(map[string]int),
+		PosTags:           make(map[string]int),
+		Relations:         make(map[string]int),
+		SentenceLengths:   make(map[int]int),
+		DependencyLengths: make(map[int]int),
+	}
+}
+
+// Collect reads all sentences from a reader and returns their
+// statistics.
+func Collect(reader conllx.SentenceReader) (*Statistics, error) {
+	s := New()
+
+	for {
+		sentence, err := reader.ReadSentence()
+		if err == io.EOF {
+			return s, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		s.Add(sentence)
+	}
+}
+
+// Add updates the statistics with a sentence.
+func (s *Statistics) Add(sentence conllx.Sentence) {
+	s.Sentences++
+	s.Tokens += len(sentence)
+	s.SentenceLengths[len(sentence)]++
+
+	for idx := range sentence {
+		token := &sentence[idx]
+
+		countLayer(s.Forms, token.Form)
+		countLayer(s.Lemmas, token.Lemma)
+		countLayer(s.CoarsePosTags, token.CoarsePosTag)
+		countLayer(s.PosTags, token.PosTag)
+		countLayer(s.Relations, token.HeadRel)
+
+		if head, ok := token.Head(); ok && head != 0 {
+			length := int(head) - (idx + 1)
+			if length < 0 {
+				length = -length
+			}
+
+			s.DependencyLengths[length]++
+		}
+	}
+
+	if arcs, err := sentence.NonProjectiveArcs(); err == nil {
+		s.Trees++
+
+		if len(arcs) != 0 {
+			s.NonProjectiveSentences++
+			s.NonProjectiveArcs += len(arcs)
+		}
+	}
+}
+
+func countLayer(counts map[string]int, getter func() (string, bool)) {
+	if value, ok := getter(); ok {
+		counts[value]++
+	}
+}
+
+// NonProjectivityRate returns the fraction of the well-formed trees
+// that is non-projective.
+func (s *Statistics) NonProjectivityRate() float64 {
+	if s.Trees == 0 {
+		return 0
+	}
+
+	return float64(s.NonProjectiveSentences) / float64(s.Trees)
+}
+
+// A Summary contains the scalar statistics and histograms of a corpus,
+// without the frequency maps. It is suitable for JSON serialization.
+type Summary struct {
+	Sentences              int         `json:"sentences"`
+	Tokens                 int         `json:"tokens"`
+	Forms                  int         `json:"forms"`
+	Lemmas                 int         `json:"lemmas"`
+	CoarsePosTags          int         `json:"coarse_pos_tags"`
+	PosTags                int         `json:"pos_tags"`
+	Relations              int         `json:"relations"`
+	Trees                  int         `json:"trees"`
+	NonProjectiveSentences int         `json:"non_projective_sentences"`
+	NonProjectiveArcs      int         `json:"non_projective_arcs"`
+	NonProjectivityRate    float64     `json:"non_projectivity_rate"`
+	SentenceLengths        map[int]int `json:"sentence_lengths"`
+	DependencyLengths      map[int]int `json:"dependency_lengths"`
+}
+
+// Summary returns a summary of the statistics. The form, lemma, tag,
+// and relation fields of the summary are the number of distinct values.
+func (s *Statistics) Summary() Summary {
+	return Summary{
+		Sentences:              s.Sentences,
+		Tokens:                 s.Tokens,
+		Forms:                  len(s.Forms),
+		Lemmas:                 len(s.Lemmas),
+		CoarsePosTags:          len(s.CoarsePosTags),
+		PosTags:                len(s.PosTags),
+		Relations:              len(s.Relations),
+		Trees:                  s.Trees,
+		NonProjectiveSentences: s.NonProjectiveSentences,
+		NonProjectiveArcs:      s.NonProjectiveArcs,
+		NonProjectivityRate:    s.NonProjectivityRate(),
+		SentenceLengths:        s.SentenceLengths,
+		DependencyLengths:      s.DependencyLengths,
+	}
+}
+
+// WriteTable writes the statistics as a human-readable table.
+func (s *Statistics) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+
+	summary := s.Summary()
+	fmt.Fprintf(tw, "Sentences\t%d\n", summary.Sentences)
+	fmt.Fprintf(tw, "Tokens\t%d\n", summary.Tokens)
+	fmt.Fprintf(tw, "Distinct forms\t%d\n", summary.Forms)
+	fmt.Fprintf(tw, "Distinct lemmas\t%d\n", summary.Lemmas)
+	fmt.Fprintf(tw, "Distinct coarse POS tags\t%d\n", summary.CoarsePosTags)
+	fmt.Fprintf(tw, "Distinct POS tags\t%d\n", summary.PosTags)
+	fmt.Fprintf(tw, "Distinct relations\t%d\n", summary.Relations)
+	fmt.Fprintf(tw, "Trees\t%d\n", summary.Trees)
+	fmt.Fprintf(tw, "Non-projective sentences\t%d\n", summary.NonProjectiveSentences)
+	fmt.Fprintf(tw, "Non-projective arcs\t%d\n", summary.NonProjectiveArcs)
+	fmt.Fprintf(tw, "Non-projectivity rate\t%.4f\n", summary.NonProjectivityRate)
+
+	fmt.Fprintln(tw)
+	writeHistogram(tw, "Sentence length", summary.SentenceLengths)
+
+	fmt.Fprintln(tw)
+	writeHistogram(tw, "Dependency length", summary.DependencyLengths)
+
+	return tw.Flush()
+}
+
+func writeHistogram(w io.Writer, name string, histogram map[int]int) {
+	keys := make([]int, 0, len(histogram))
+	for key := range histogram {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+
+	fmt.Fprintf(w, "%s\tFrequency\n", name)
+	for _, key := range keys {
+		fmt.Fprintf(w, "%d\t%d\n", key, histogram[key])
+	}
+}
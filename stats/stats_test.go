@@ -0,0 +1,77 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"bufio"
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+const testFragment string = `1	Die	die	ART	ART	nsf	2	DET
+2	Großaufnahme	Großaufnahme	N	NN	nsf	0	ROOT
+
+1	Gilles	Gilles	N	NE	nsm	0	ROOT
+2	Deleuze	Deleuze	N	NE	case:nominative|number:singular|gender:masculine	1	APP
+
+1	a	_	_	_	_	0	ROOT
+2	b	_	_	_	_	4	X
+3	c	_	_	_	_	1	X
+4	d	_	_	_	_	1	X
+
+1	kaputt`
+
+func collectString(t *testing.T, data string) *Statistics {
+	s, err := Collect(conllx.NewReader(bufio.NewReader(strings.NewReader(data))))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	return s
+}
+
+func TestCollect(t *testing.T) {
+	s := collectString(t, testFragment)
+
+	summary := s.Summary()
+	expected := Summary{
+		Sentences:              4,
+		Tokens:                 9,
+		Forms:                  9,
+		Lemmas:                 4,
+		CoarsePosTags:          2,
+		PosTags:                3,
+		Relations:              4,
+		Trees:                  3,
+		NonProjectiveSentences: 1,
+		NonProjectiveArcs:      1,
+		NonProjectivityRate:    1.0 / 3.0,
+		SentenceLengths:        map[int]int{1: 1, 2: 2, 4: 1},
+		DependencyLengths:      map[int]int{1: 2, 2: 2, 3: 1},
+	}
+
+	if !reflect.DeepEqual(summary, expected) {
+		t.Fatalf("Expected:\n%+v\nGot:\n%+v", expected, summary)
+	}
+
+	if s.PosTags["NE"] != 2 {
+		t.Fatalf("Expected NE frequency 2, got %d", s.PosTags["NE"])
+	}
+}
+
+func TestWriteTable(t *testing.T) {
+	var buf bytes.Buffer
+	if err := collectString(t, testFragment).WriteTable(&buf); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if !strings.Contains(buf.String(), "Non-projective sentences  1\n") {
+		t.Fatalf("Unexpected table:\n%s", buf.String())
+	}
+}
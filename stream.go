@@ -0,0 +1,81 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conllx
+
+import (
+	"context"
+	"io"
+	"iter"
+)
+
+// Sentences returns an iterator over the sentences of a SentenceReader.
+// Iteration ends when the reader is exhausted. If reading fails or the
+// context is cancelled, the error is yielded with a nil sentence and
+// iteration ends.
+//
+// In contrast to ReadSentence, the sentences that are yielded are owned
+// by the caller and remain valid after the iteration continues.
+func Sentences(ctx context.Context, reader SentenceReader) iter.Seq2[Sentence, error] {
+	return func(yield func(Sentence, error) bool) {
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(nil, err)
+				return
+			}
+
+			sentence, err := reader.ReadSentence()
+			if err == io.EOF {
+				return
+			}
+
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			if !yield(sentence.Clone(), nil) {
+				return
+			}
+		}
+	}
+}
+
+// SentenceChan starts a goroutine that reads sentences from a
+// SentenceReader and sends them on the returned sentence channel. The
+// channel has a buffer of the given size.
+//
+// Both channels are closed when the reader is exhausted, reading fails,
+// or the context is cancelled. In the last two cases, the error is sent
+// on the error channel before it is closed. The caller must cancel the
+// context if it stops receiving before the sentence channel is closed,
+// otherwise the goroutine leaks.
+//
+// Like with Sentences, the sentences that are sent are owned by the
+// receiver.
+func SentenceChan(ctx context.Context, reader SentenceReader, bufSize int) (<-chan Sentence, <-chan error) {
+	sentences := make(chan Sentence, bufSize)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(sentences)
+
+		for sentence, err := range Sentences(ctx, reader) {
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			select {
+			case sentences <- sentence:
+			case <-ctx.Done():
+				errs <- ctx.Err()
+				return
+			}
+		}
+	}()
+
+	return sentences, errs
+}
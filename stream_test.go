@@ -0,0 +1,104 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conllx
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSentences(t *testing.T) {
+	var sentences []Sentence
+	for sentence, err := range Sentences(context.Background(), stringReader(longShortFragment)) {
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		sentences = append(sentences, sentence)
+	}
+
+	if len(sentences) != 2 {
+		t.Fatalf("Expected 2 sentences, got %d", len(sentences))
+	}
+
+	// Sentences should not be recycled.
+	equalOrFail(t, nil, longShortSentence1, sentences[0])
+	equalOrFail(t, nil, longShortSentence2, sentences[1])
+}
+
+func TestSentencesError(t *testing.T) {
+	n := 0
+	for sentence, err := range Sentences(context.Background(), stringReader("1\ta\n\ntest")) {
+		n++
+
+		if n == 1 && err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if n == 2 && (err == nil || sentence != nil) {
+			t.Fatal("Expected parse error without sentence")
+		}
+	}
+
+	if n != 2 {
+		t.Fatalf("Expected iteration to stop after the error, got %d values", n)
+	}
+}
+
+func TestSentencesCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for _, err := range Sentences(ctx, stringReader(longShortFragment)) {
+		if err != context.Canceled {
+			t.Fatal("Expected cancellation error, got:", err)
+		}
+	}
+}
+
+func TestSentenceChan(t *testing.T) {
+	sentences, errs := SentenceChan(context.Background(), stringReader(longShortFragment), 0)
+
+	s1 := <-sentences
+	s2 := <-sentences
+
+	if _, ok := <-sentences; ok {
+		t.Fatal("Sentence channel should be closed")
+	}
+
+	if err := <-errs; err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	equalOrFail(t, nil, longShortSentence1, s1)
+	equalOrFail(t, nil, longShortSentence2, s2)
+}
+
+func TestSentenceChanError(t *testing.T) {
+	sentences, errs := SentenceChan(context.Background(), stringReader("test"), 1)
+
+	for range sentences {
+		t.Fatal("No sentence should be sent")
+	}
+
+	if err := <-errs; err == nil {
+		t.Fatal("Expected parse error")
+	}
+}
+
+func TestSentenceChanCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	sentences, errs := SentenceChan(ctx, stringReader(longShortFragment), 0)
+
+	<-sentences
+	cancel()
+
+	for range sentences {
+	}
+
+	if err := <-errs; err != nil && err != context.Canceled {
+		t.Fatal("Expected cancellation error, got:", err)
+	}
+}
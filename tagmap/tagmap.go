@@ -0,0 +1,178 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package tagmap maps the values of annotation layers, such as
+// part-of-speech tags and dependency relations, between tag sets.
+package tagmap
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+type entry struct {
+	conditions map[string]string
+	to         string
+}
+
+// A Mapping maps values of an annotation layer to other values. A
+// mapping entry can be conditioned on the features of a token. Values
+// for which there is no matching entry are left unchanged and are
+// counted, so that they can be reported.
+type Mapping struct {
+	entries  map[string][]entry
+	unmapped map[string]int
+}
+
+// NewMapping creates an empty mapping.
+func NewMapping() *Mapping {
+	return &Mapping{
+		entries:  make(map[string][]entry),
+		unmapped: make(map[string]int),
+	}
+}
+
+// Add adds an unconditional mapping entry.
+func (m *Mapping) Add(from, to string) {
+	m.AddConditional(from, nil, to)
+}
+
+// AddConditional adds an entry that only applies when the token has all
+// the given features. Conditional entries take precedence over the
+// unconditional entry for a value. Conditional entries for the same
+// value are tried in the order in which they were added.
+func (m *Mapping) AddConditional(from string, conditions map[string]string, to string) {
+	e := entry{conditions, to}
+
+	if len(conditions) == 0 {
+		m.entries[from] = append(m.entries[from], e)
+		return
+	}
+
+	// Insert before the first unconditional entry.
+	entries := m.entries[from]
+	idx := len(entries)
+	for i, other := range entries {
+		if len(other.conditions) == 0 {
+			idx = i
+			break
+		}
+	}
+
+	entries = append(entries, entry{})
+	copy(entries[idx+1:], entries[idx:])
+	entries[idx] = e
+	m.entries[from] = entries
+}
+
+// ReadMapping reads a mapping from tab-separated lines. Each line
+// contains either two columns, the value and its mapping, or three
+// columns: the value, the features that the token must have, and the
+// mapping. Features use the CoNLL-X features format, e.g.
+// case:nom|number:sg. Empty lines and lines starting with # are
+// ignored.
+func ReadMapping(r io.Reader) (*Mapping, error) {
+	m := NewMapping()
+	scanner := bufio.NewScanner(r)
+
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+
+		columns := strings.Split(line, "\t")
+
+		switch len(columns) {
+		case 2:
+			m.Add(columns[0], columns[1])
+		case 3:
+			conditions := conllx.NewFeatures(columns[1]).FeaturesMap()
+			if len(conditions) == 0 {
+				return nil, fmt.Errorf("Line %d: invalid feature conditions: %s", lineNo, columns[1])
+			}
+			m.AddConditional(columns[0], conditions, columns[2])
+		default:
+			return nil, fmt.Errorf("Line %d: expected 2 or 3 columns, got %d", lineNo, len(columns))
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Map returns the mapping of a value of the given token. The second
+// tuple element is false when there is no applicable mapping.
+func (m *Mapping) Map(token *conllx.Token, value string) (string, bool) {
+	entries, ok := m.entries[value]
+	if !ok {
+		return "", false
+	}
+
+	var features map[string]string
+	if f, ok := token.Features(); ok {
+		features = f.FeaturesMap()
+	}
+
+	for _, e := range entries {
+		if conditionsHold(e.conditions, features) {
+			return e.to, true
+		}
+	}
+
+	return "", false
+}
+
+func conditionsHold(conditions, features map[string]string) bool {
+	for feature, value := range conditions {
+		if features[feature] != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Apply maps a layer of the tokens of a sentence in place. Tokens that
+// do not have the layer are skipped, values that cannot be mapped are
+// counted as unmapped.
+func (m *Mapping) Apply(sentence conllx.Sentence, layer conllx.Layer) {
+	for idx := range sentence {
+		token := &sentence[idx]
+
+		value, ok := token.Layer(layer)
+		if !ok {
+			continue
+		}
+
+		if mapped, ok := m.Map(token, value); ok {
+			token.SetLayer(layer, mapped)
+		} else {
+			m.unmapped[value]++
+		}
+	}
+}
+
+// Unmapped returns the values that could not be mapped by Apply, with
+// their frequencies.
+func (m *Mapping) Unmapped() map[string]int {
+	return m.unmapped
+}
+
+// NewReader returns a reader that maps the given layer of the sentences
+// of 'reader'. Mappings for multiple layers can be applied by wrapping
+// readers.
+func NewReader(reader conllx.SentenceReader, layer conllx.Layer, m *Mapping) conllx.SentenceReader {
+	return conllx.NewMapReader(reader, func(sentence conllx.Sentence) (conllx.Sentence, error) {
+		m.Apply(sentence, layer)
+		return sentence, nil
+	})
+}
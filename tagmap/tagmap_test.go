@@ -0,0 +1,97 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tagmap
+
+import (
+	"bufio"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+const testMapping string = `# STTS to UPOS
+ART	DET
+PPER	PRON
+NN	NOUN
+VAFIN	AUX
+VAFIN	type:full	VERB
+`
+
+const testFragment string = `1	Die	die	ART	ART	_	2	DET
+2	Katze	Katze	N	NN	_	3	SUBJ
+3	hat	haben	V	VAFIN	_	0	ROOT
+
+1	Sie	sie	PRO	PPER	_	2	SUBJ
+2	hat	haben	V	VAFIN	type:full	0	ROOT
+3	Hunger	Hunger	N	NN	_	2	OBJA
+4	.	.	$.	$.	_	2	PUNCT`
+
+func TestReadMapping(t *testing.T) {
+	m, err := ReadMapping(strings.NewReader(testMapping))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	reader := NewReader(
+		conllx.NewReader(bufio.NewReader(strings.NewReader(testFragment))),
+		conllx.PosTagLayer, m)
+
+	for _, expected := range [][]string{
+		{"DET", "NOUN", "AUX"},
+		{"PRON", "VERB", "NOUN", "$."},
+	} {
+		sentence, err := reader.ReadSentence()
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		var tags []string
+		for idx := range sentence {
+			tag, _ := sentence[idx].PosTag()
+			tags = append(tags, tag)
+		}
+
+		if !reflect.DeepEqual(tags, expected) {
+			t.Fatalf("Expected tags %v, got %v", expected, tags)
+		}
+	}
+
+	if !reflect.DeepEqual(m.Unmapped(), map[string]int{"$.": 1}) {
+		t.Fatal("Unexpected unmapped values:", m.Unmapped())
+	}
+}
+
+func TestConditionalPrecedence(t *testing.T) {
+	m := NewMapping()
+	m.Add("V", "VERB")
+	m.AddConditional("V", map[string]string{"aux": "yes"}, "AUX")
+
+	token := conllx.NewToken().SetFeatures(map[string]string{"aux": "yes"})
+	if mapped, _ := m.Map(token, "V"); mapped != "AUX" {
+		t.Fatal("Conditional mapping should take precedence, got:", mapped)
+	}
+
+	if mapped, _ := m.Map(conllx.NewToken(), "V"); mapped != "VERB" {
+		t.Fatal("Token without features should use the unconditional mapping, got:", mapped)
+	}
+
+	if _, ok := m.Map(conllx.NewToken(), "N"); ok {
+		t.Fatal("Unknown values should not be mapped")
+	}
+}
+
+func TestReadMappingErrors(t *testing.T) {
+	for _, mapping := range []string{
+		"ART",
+		"ART\tx\ty\tz",
+		"ART\tnofeature\tDET",
+	} {
+		if _, err := ReadMapping(strings.NewReader(mapping)); err == nil {
+			t.Fatalf("Mapping should not be read: %q", mapping)
+		}
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package tensor exports batches of CoNLL-X sentences as padded integer
+// matrices in the NumPy .npy/.npz formats, so that they can be loaded
+// directly by training jobs.
+package tensor
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/danieldk/conllx.v1"
+	"gopkg.in/danieldk/conllx.v1/vocab"
+)
+
+// Options configure an Exporter.
+type Options struct {
+	// Layers that are exported as matrices of vocabulary identifiers.
+	// Every layer must have a vocabulary.
+	Layers []conllx.Layer
+
+	// Heads enables the export of the head matrix.
+	Heads bool
+
+	// BatchSize is the maximum number of sentences in a batch.
+	BatchSize int
+
+	// BucketWidth enables bucketing by length when it is larger than
+	// zero: only sentences whose lengths fall in the same bucket of
+	// BucketWidth lengths are put in the same batch.
+	BucketWidth int
+
+	// Padding is the value of padding positions in layer matrices.
+	Padding int32
+
+	// HeadPadding is the value of padding positions and absent heads
+	// in the head matrix.
+	HeadPadding int32
+}
+
+// DefaultOptions returns options that export the form, POS tag, and
+// relation layers and the heads in batches of 32 sentences. Layers are
+// padded with vocab.PaddingID, heads with -1.
+func DefaultOptions() Options {
+	return Options{
+		Layers:      []conllx.Layer{conllx.FormLayer, conllx.PosTagLayer, conllx.HeadRelLayer},
+		Heads:       true,
+		BatchSize:   32,
+		Padding:     vocab.PaddingID,
+		HeadPadding: -1,
+	}
+}
+
+// A Batch of sentences as named matrices. Every batch has the following
+// arrays:
+//
+//   - index: the position of each sentence in the corpus (batch_size)
+//   - length: the length of each sentence (batch_size)
+//   - mask: 1 for tokens, 0 for padding (batch_size, max_len)
+//
+// Additionally, a batch contains the arrays of the exported layers,
+// named after the layers (e.g. form or deprel), and the head array.
+type Batch struct {
+	Arrays []Array
+}
+
+// WriteNpz writes the batch in the NumPy .npz format.
+func (b *Batch) WriteNpz(w io.Writer) error {
+	return WriteNpz(w, b.Arrays)
+}
+
+// An Exporter converts sentences to batches.
+type Exporter struct {
+	vocabs  *vocab.Vocabularies
+	options Options
+}
+
+// NewExporter creates an exporter that uses the given vocabularies to
+// map layer values to identifiers.
+func NewExporter(vocabs *vocab.Vocabularies, options Options) (*Exporter, error) {
+	if options.BatchSize < 1 {
+		return nil, errors.New("Batch size should be at least 1.")
+	}
+
+	for _, layer := range options.Layers {
+		if _, ok := vocabs.Vocab(layer); !ok {
+			return nil, fmt.Errorf("No vocabulary for layer: %s", layer)
+		}
+	}
+
+	return &Exporter{
+		vocabs:  vocabs,
+		options: options,
+	}, nil
+}
+
+type indexedSentence struct {
+	index    int
+	sentence *vocab.Encoded
+}
+
+// Export reads all sentences from the reader, groups them in batches,
+// and calls 'f' for every batch. Without bucketing, batches are formed
+// in corpus order. With bucketing, a batch is emitted as soon as its
+// bucket is full and the remaining partial batches are emitted in the
+// order of increasing length at the end.
+func (e *Exporter) Export(reader conllx.SentenceReader, f func(*Batch) error) error {
+	buckets := make(map[int][]indexedSentence)
+
+	for index := 0; ; index++ {
+		sentence, err := reader.ReadSentence()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		bucket := 0
+		if e.options.BucketWidth > 0 {
+			bucket = len(sentence) / e.options.BucketWidth
+		}
+
+		buckets[bucket] = append(buckets[bucket], indexedSentence{index, e.vocabs.Encode(sentence)})
+
+		if len(buckets[bucket]) == e.options.BatchSize {
+			if err := f(e.batch(buckets[bucket])); err != nil {
+				return err
+			}
+
+			buckets[bucket] = nil
+		}
+	}
+
+	keys := make([]int, 0, len(buckets))
+	for bucket := range buckets {
+		keys = append(keys, bucket)
+	}
+	sort.Ints(keys)
+
+	for _, bucket := range keys {
+		if len(buckets[bucket]) == 0 {
+			continue
+		}
+
+		if err := f(e.batch(buckets[bucket])); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportNpz exports the batches of the reader to .npz files in the
+// directory 'dir', named batch-00000.npz, batch-00001.npz, etc.
+func (e *Exporter) ExportNpz(reader conllx.SentenceReader, dir string) error {
+	n := 0
+	return e.Export(reader, func(batch *Batch) error {
+		f, err := os.Create(filepath.Join(dir, fmt.Sprintf("batch-%05d.npz", n)))
+		if err != nil {
+			return err
+		}
+
+		n++
+
+		if err := batch.WriteNpz(f); err != nil {
+			f.Close()
+			return err
+		}
+
+		return f.Close()
+	})
+}
+
+func (e *Exporter) batch(sentences []indexedSentence) *Batch {
+	batchSize := len(sentences)
+
+	maxLen := 0
+	for _, s := range sentences {
+		if len(s.sentence.Heads) > maxLen {
+			maxLen = len(s.sentence.Heads)
+		}
+	}
+
+	shape := []int{batchSize, maxLen}
+
+	index := Array{Name: "index", Shape: []int{batchSize}, Data: make([]int32, batchSize)}
+	length := Array{Name: "length", Shape: []int{batchSize}, Data: make([]int32, batchSize)}
+	mask := Array{Name: "mask", Shape: shape, Data: make([]int32, batchSize*maxLen)}
+
+	for row, s := range sentences {
+		index.Data[row] = int32(s.index)
+		length.Data[row] = int32(len(s.sentence.Heads))
+
+		for col := range s.sentence.Heads {
+			mask.Data[row*maxLen+col] = 1
+		}
+	}
+
+	arrays := []Array{index, length, mask}
+
+	for _, layer := range e.options.Layers {
+		array := paddedArray(layer.String(), shape, e.options.Padding)
+		for row, s := range sentences {
+			for col, id := range s.sentence.Layers[layer] {
+				array.Data[row*maxLen+col] = int32(id)
+			}
+		}
+
+		arrays = append(arrays, array)
+	}
+
+	if e.options.Heads {
+		array := paddedArray("head", shape, e.options.HeadPadding)
+		for row, s := range sentences {
+			for col, head := range s.sentence.Heads {
+				if head >= 0 {
+					array.Data[row*maxLen+col] = int32(head)
+				}
+			}
+		}
+
+		arrays = append(arrays, array)
+	}
+
+	return &Batch{Arrays: arrays}
+}
+
+func paddedArray(name string, shape []int, padding int32) Array {
+	data := make([]int32, shape[0]*shape[1])
+	for idx := range data {
+		data[idx] = padding
+	}
+
+	return Array{Name: name, Shape: shape, Data: data}
+}
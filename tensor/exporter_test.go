@@ -0,0 +1,142 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensor
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/danieldk/conllx.v1"
+	"gopkg.in/danieldk/conllx.v1/vocab"
+)
+
+const testFragment string = `1	a	_	_	X	_	0	ROOT
+
+1	a	_	_	X	_	2	DEP
+2	b	_	_	Y	_	0	ROOT
+3	c	_	_	Y	_	2	DEP
+
+1	b	_	_	Y	_	0	ROOT
+
+1	c	_	_	Y	_	3	DEP
+2	c	_	_	Y	_	3	DEP
+3	a	_	_	X	_	0	ROOT`
+
+func testReader() conllx.SentenceReader {
+	return conllx.NewReader(bufio.NewReader(strings.NewReader(testFragment)))
+}
+
+func testExporter(t *testing.T, options Options) *Exporter {
+	vocabs, err := vocab.Build(testReader(), 1, conllx.FormLayer, conllx.PosTagLayer, conllx.HeadRelLayer)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	e, err := NewExporter(vocabs, options)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	return e
+}
+
+func exportBatches(t *testing.T, e *Exporter) []*Batch {
+	var batches []*Batch
+	err := e.Export(testReader(), func(batch *Batch) error {
+		batches = append(batches, batch)
+		return nil
+	})
+
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	return batches
+}
+
+func arrayByName(t *testing.T, batch *Batch, name string) Array {
+	for _, array := range batch.Arrays {
+		if array.Name == name {
+			return array
+		}
+	}
+
+	t.Fatalf("Batch has no array %s", name)
+	return Array{}
+}
+
+func TestExport(t *testing.T) {
+	options := DefaultOptions()
+	options.Layers = []conllx.Layer{conllx.FormLayer}
+	options.BatchSize = 2
+
+	batches := exportBatches(t, testExporter(t, options))
+	if len(batches) != 2 {
+		t.Fatalf("Expected 2 batches, got %d", len(batches))
+	}
+
+	batch := batches[0]
+
+	// Form identifiers: a, c occur 3 times, b twice.
+	expected := map[string]Array{
+		"index":  {"index", []int{2}, []int32{0, 1}},
+		"length": {"length", []int{2}, []int32{1, 3}},
+		"mask":   {"mask", []int{2, 3}, []int32{1, 0, 0, 1, 1, 1}},
+		"form":   {"form", []int{2, 3}, []int32{2, 0, 0, 2, 4, 3}},
+		"head":   {"head", []int{2, 3}, []int32{0, -1, -1, 2, 0, 2}},
+	}
+
+	if len(batch.Arrays) != len(expected) {
+		t.Fatalf("Expected %d arrays, got %d", len(expected), len(batch.Arrays))
+	}
+
+	for name, array := range expected {
+		if !reflect.DeepEqual(arrayByName(t, batch, name), array) {
+			t.Fatalf("Expected %v, got %v", array, arrayByName(t, batch, name))
+		}
+	}
+}
+
+func TestExportBucketed(t *testing.T) {
+	options := DefaultOptions()
+	options.BatchSize = 2
+	options.BucketWidth = 2
+
+	var indices [][]int32
+	for _, batch := range exportBatches(t, testExporter(t, options)) {
+		indices = append(indices, arrayByName(t, batch, "index").Data)
+	}
+
+	if !reflect.DeepEqual(indices, [][]int32{{0, 2}, {1, 3}}) {
+		t.Fatal("Unexpected bucketed batches:", indices)
+	}
+}
+
+func TestExportMissingVocab(t *testing.T) {
+	vocabs, _ := vocab.Build(testReader(), 1, conllx.FormLayer)
+	if _, err := NewExporter(vocabs, DefaultOptions()); err == nil {
+		t.Fatal("expected error for a layer without vocabulary")
+	}
+}
+
+func TestExportNpz(t *testing.T) {
+	dir := t.TempDir()
+	options := DefaultOptions()
+	options.BatchSize = 3
+
+	if err := testExporter(t, options).ExportNpz(testReader(), dir); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	for _, name := range []string{"batch-00000.npz", "batch-00001.npz"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Fatal("Expected batch file:", err)
+		}
+	}
+}
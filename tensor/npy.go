@@ -0,0 +1,87 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensor
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// An Array is a named, dense int32 array in row-major order.
+type Array struct {
+	Name  string
+	Shape []int
+	Data  []int32
+}
+
+var npyMagic = []byte("\x93NUMPY")
+
+// WriteNpy writes an array in the NumPy .npy format (version 1.0).
+func WriteNpy(w io.Writer, array Array) error {
+	size := 1
+	for _, dim := range array.Shape {
+		size *= dim
+	}
+
+	if size != len(array.Data) {
+		return fmt.Errorf("Array %s has %d elements, shape requires %d", array.Name, len(array.Data), size)
+	}
+
+	dims := make([]string, len(array.Shape))
+	for idx, dim := range array.Shape {
+		dims[idx] = fmt.Sprintf("%d", dim)
+	}
+
+	shape := strings.Join(dims, ", ")
+	if len(array.Shape) == 1 {
+		shape += ","
+	}
+
+	header := fmt.Sprintf("{'descr': '<i4', 'fortran_order': False, 'shape': (%s), }", shape)
+
+	// The magic string, version, header length, and header should be
+	// aligned to 64 bytes. The header is terminated by a newline.
+	preambleLen := len(npyMagic) + 4
+	padding := 64 - (preambleLen+len(header)+1)%64
+	if padding == 64 {
+		padding = 0
+	}
+	header += strings.Repeat(" ", padding) + "\n"
+
+	var buf bytes.Buffer
+	buf.Write(npyMagic)
+	buf.Write([]byte{1, 0})
+	binary.Write(&buf, binary.LittleEndian, uint16(len(header)))
+	buf.WriteString(header)
+
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.LittleEndian, array.Data)
+}
+
+// WriteNpz writes arrays in the NumPy .npz format. Each array is stored
+// as NAME.npy in the archive.
+func WriteNpz(w io.Writer, arrays []Array) error {
+	zw := zip.NewWriter(w)
+
+	for _, array := range arrays {
+		fw, err := zw.Create(array.Name + ".npy")
+		if err != nil {
+			return err
+		}
+
+		if err := WriteNpy(fw, array); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
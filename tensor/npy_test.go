@@ -0,0 +1,99 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tensor
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWriteNpy(t *testing.T) {
+	var buf bytes.Buffer
+	array := Array{Name: "test", Shape: []int{2, 3}, Data: []int32{1, 2, 3, 4, 5, -1}}
+	if err := WriteNpy(&buf, array); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	data := buf.Bytes()
+	if !bytes.HasPrefix(data, []byte("\x93NUMPY\x01\x00")) {
+		t.Fatal("Missing .npy magic and version")
+	}
+
+	headerLen := int(binary.LittleEndian.Uint16(data[8:10]))
+	if (10+headerLen)%64 != 0 {
+		t.Fatalf("Header is not aligned: %d", 10+headerLen)
+	}
+
+	header := string(data[10 : 10+headerLen])
+	if !strings.HasPrefix(header, "{'descr': '<i4', 'fortran_order': False, 'shape': (2, 3), }") ||
+		!strings.HasSuffix(header, "\n") {
+		t.Fatalf("Unexpected header: %q", header)
+	}
+
+	values := make([]int32, 6)
+	if err := binary.Read(bytes.NewReader(data[10+headerLen:]), binary.LittleEndian, values); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if !reflect.DeepEqual(values, array.Data) {
+		t.Fatal("Unexpected array data:", values)
+	}
+}
+
+func TestWriteNpyShape(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteNpy(&buf, Array{Name: "vec", Shape: []int{3}, Data: []int32{1, 2, 3}}); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if !strings.Contains(buf.String(), "'shape': (3,)") {
+		t.Fatal("One-dimensional shapes should be written as 1-tuples")
+	}
+
+	if err := WriteNpy(&buf, Array{Name: "bad", Shape: []int{2, 2}, Data: []int32{1}}); err == nil {
+		t.Fatal("expected error for an array that does not match its shape")
+	}
+}
+
+func TestWriteNpz(t *testing.T) {
+	var buf bytes.Buffer
+	arrays := []Array{
+		{Name: "a", Shape: []int{1}, Data: []int32{1}},
+		{Name: "b", Shape: []int{2}, Data: []int32{2, 3}},
+	}
+
+	if err := WriteNpz(&buf, arrays); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	for idx, f := range zr.File {
+		if f.Name != arrays[idx].Name+".npy" {
+			t.Fatalf("Unexpected archive member: %s", f.Name)
+		}
+
+		r, err := f.Open()
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		var npy bytes.Buffer
+		WriteNpy(&npy, arrays[idx])
+
+		data, _ := io.ReadAll(r)
+		if !bytes.Equal(data, npy.Bytes()) {
+			t.Fatalf("Archive member %s differs from .npy serialization", f.Name)
+		}
+	}
+}
@@ -0,0 +1,321 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package tiger reads treebanks in the TIGER-XML and NEGRA export
+// formats and converts their graphs, which can have discontinuous
+// constituents, to dependency trees.
+package tiger
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"gopkg.in/danieldk/conllx.v1"
+	"gopkg.in/danieldk/conllx.v1/ptb"
+)
+
+// A terminal is a word of a sentence graph.
+type terminal struct {
+	word  string
+	lemma string
+	pos   string
+	morph string
+}
+
+// An edge connects a nonterminal to a child, which is either a terminal
+// or a nonterminal.
+type edge struct {
+	label string
+	child string
+}
+
+// A nonterminal is a constituent of a sentence graph.
+type nonterminal struct {
+	cat   string
+	edges []edge
+}
+
+// A graph is a sentence in TIGER or NEGRA format. Terminals and
+// nonterminals share one identifier space.
+type graph struct {
+	terminals    []terminal
+	terminalIDs  map[string]int
+	nonterminals map[string]*nonterminal
+	root         string
+}
+
+func newGraph() *graph {
+	return &graph{
+		terminalIDs:  make(map[string]int),
+		nonterminals: make(map[string]*nonterminal),
+	}
+}
+
+func (g *graph) addTerminal(id string, t terminal) {
+	g.terminalIDs[id] = len(g.terminals)
+	g.terminals = append(g.terminals, t)
+}
+
+// The default head edge labels: heads (HD), adpositions (AC), and first
+// conjuncts (CJ).
+var defaultHeadLabels = []string{"HD", "AC", "CJ"}
+
+// Head rules for constituents that do not have a child with a head edge
+// label, using the categories and part-of-speech tags of TIGER.
+const defaultHeadRules = `
+AP right ADJA ADJD AP CAP
+AVP right ADV AVP CAVP
+MPN right NE NN FM
+NM right CARD
+NP right NN NE PPER PIS PDS PWS PRF PPOSS NP CNP MPN PN NM
+PN right NE NN FM
+VROOT left S CS VP CVP NP CNP PP CPP AP CAP AVP CAVP CO DL MPN PN NM CH
+`
+
+// A Converter converts sentence graphs to dependency trees. The head of
+// a constituent is the child that is attached with one of the head edge
+// labels. The head labels are tried in order and the first child with
+// a label is used. If no child has a head label, the head rules are
+// applied to the categories (nonterminals) and part-of-speech tags
+// (terminals) of the children.
+//
+// Dependents are attached to the lexical head of the constituent, using
+// the label of the edge to the maximal projection of the dependent as
+// the dependency relation.
+type Converter struct {
+	headLabels []string
+	rules      ptb.HeadRules
+	rootRel    string
+}
+
+// NewConverter creates a converter with the given head edge labels and
+// head rules.
+func NewConverter(headLabels []string, rules ptb.HeadRules) *Converter {
+	return &Converter{
+		headLabels: headLabels,
+		rules:      rules,
+		rootRel:    "ROOT",
+	}
+}
+
+// DefaultConverter creates a converter for the TIGER annotation scheme.
+// The head edge labels are HD, AC, and CJ. The head rules cover
+// constituents without such edges, such as noun phrases.
+func DefaultConverter() *Converter {
+	rules, err := ptb.ReadHeadRules(strings.NewReader(defaultHeadRules))
+	if err != nil {
+		panic("tiger: invalid default head rules: " + err.Error())
+	}
+
+	return NewConverter(defaultHeadLabels, rules)
+}
+
+// convert converts a sentence graph to a dependency tree. Terminals that
+// are not dominated by the root are attached to the root token using
+// the relation --.
+func (c *Converter) convert(g *graph) (conllx.Sentence, error) {
+	if len(g.terminals) == 0 {
+		return nil, errors.New("Sentence has no terminals")
+	}
+
+	sentence := make(conllx.Sentence, len(g.terminals))
+	for idx, t := range g.terminals {
+		token := &sentence[idx]
+		token.SetForm(t.word)
+
+		if t.lemma != "" && t.lemma != "--" {
+			token.SetLemma(t.lemma)
+		}
+
+		if t.pos != "" {
+			token.SetPosTag(t.pos)
+		}
+
+		if morph := compactMorph(t.morph); morph != "" {
+			token.SetLayer(conllx.FeaturesLayer, morph)
+		}
+	}
+
+	state := &conversion{
+		converter: c,
+		graph:     g,
+		sentence:  sentence,
+		heads:     make(map[string]int),
+		start:     make(map[string]int),
+		visiting:  make(map[string]bool),
+	}
+
+	root, err := state.head(g.root)
+	if err != nil {
+		return nil, err
+	}
+
+	sentence[root].SetHead(0).SetHeadRel(c.rootRel)
+
+	for idx := range sentence {
+		if _, ok := sentence[idx].Head(); !ok {
+			sentence[idx].SetHead(uint(root + 1)).SetHeadRel("--")
+		}
+	}
+
+	return sentence, nil
+}
+
+// compactMorph converts a morphological analysis such as Nom.Sg.Fem to
+// a compact string of the lowercased first characters of its
+// components, such as nsf. Empty analyses (--) result in an empty
+// string.
+func compactMorph(morph string) string {
+	if morph == "" || morph == "--" {
+		return ""
+	}
+
+	var compact strings.Builder
+	for _, component := range strings.Split(morph, ".") {
+		if r, _ := utf8.DecodeRuneInString(component); r != utf8.RuneError {
+			compact.WriteRune(unicode.ToLower(r))
+		}
+	}
+
+	return compact.String()
+}
+
+// conversion holds the state of the conversion of a single graph.
+type conversion struct {
+	converter *Converter
+	graph     *graph
+	sentence  conllx.Sentence
+	heads     map[string]int
+	start     map[string]int
+	visiting  map[string]bool
+}
+
+// head returns the index of the lexical head of a node, attaching the
+// dependents within the node to their heads.
+func (s *conversion) head(id string) (int, error) {
+	if idx, ok := s.graph.terminalIDs[id]; ok {
+		return idx, nil
+	}
+
+	if head, ok := s.heads[id]; ok {
+		return head, nil
+	}
+
+	node, ok := s.graph.nonterminals[id]
+	if !ok {
+		return 0, fmt.Errorf("Unknown node: %s", id)
+	}
+
+	if len(node.edges) == 0 {
+		return 0, fmt.Errorf("Nonterminal without children: %s", id)
+	}
+
+	if s.visiting[id] {
+		return 0, fmt.Errorf("Cycle in graph at node: %s", id)
+	}
+	s.visiting[id] = true
+
+	// Children are ordered by their leftmost terminals, since edges of
+	// discontinuous constituents do not need to be in sentence order.
+	edges := append([]edge(nil), node.edges...)
+	starts := make(map[string]int)
+	for _, e := range edges {
+		start, err := s.leftmost(e.child)
+		if err != nil {
+			return 0, err
+		}
+		starts[e.child] = start
+	}
+	sort.SliceStable(edges, func(i, j int) bool {
+		return starts[edges[i].child] < starts[edges[j].child]
+	})
+
+	headIdx := s.headChild(node, edges)
+
+	head, err := s.head(edges[headIdx].child)
+	if err != nil {
+		return 0, err
+	}
+
+	for idx, e := range edges {
+		if idx == headIdx {
+			continue
+		}
+
+		dependent, err := s.head(e.child)
+		if err != nil {
+			return 0, err
+		}
+
+		s.sentence[dependent].SetHead(uint(head + 1)).SetHeadRel(e.label)
+	}
+
+	s.heads[id] = head
+
+	return head, nil
+}
+
+// headChild returns the index of the head among the (ordered) edges of
+// a nonterminal.
+func (s *conversion) headChild(node *nonterminal, edges []edge) int {
+	for _, label := range s.converter.headLabels {
+		for idx, e := range edges {
+			if e.label == label {
+				return idx
+			}
+		}
+	}
+
+	labels := make([]string, len(edges))
+	for idx, e := range edges {
+		if t, ok := s.graph.terminalIDs[e.child]; ok {
+			labels[idx] = s.graph.terminals[t].pos
+		} else if child, ok := s.graph.nonterminals[e.child]; ok {
+			labels[idx] = child.cat
+		}
+	}
+
+	return s.converter.rules.HeadChild(node.cat, labels)
+}
+
+// leftmost returns the index of the leftmost terminal dominated by a
+// node.
+func (s *conversion) leftmost(id string) (int, error) {
+	if idx, ok := s.graph.terminalIDs[id]; ok {
+		return idx, nil
+	}
+
+	if start, ok := s.start[id]; ok {
+		return start, nil
+	}
+
+	node, ok := s.graph.nonterminals[id]
+	if !ok {
+		return 0, fmt.Errorf("Unknown node: %s", id)
+	}
+
+	// Cycles are reported by head, the provisional start only ensures
+	// termination.
+	start := len(s.graph.terminals)
+	s.start[id] = start
+
+	for _, e := range node.edges {
+		childStart, err := s.leftmost(e.child)
+		if err != nil {
+			return 0, err
+		}
+
+		if childStart < start {
+			start = childStart
+		}
+	}
+
+	s.start[id] = start
+
+	return start, nil
+}
@@ -0,0 +1,173 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tiger
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+// The identifier of the virtual root node in the NEGRA export format.
+const exportRoot = "#0"
+
+var _ conllx.SentenceReader = &ExportReader{}
+
+// An ExportReader reads sentences in the NEGRA export format (versions
+// 3 and 4) and converts them to dependency trees. Secondary edges are
+// ignored. Edges to the virtual root node (0) become edges of a
+// nonterminal with the category VROOT.
+type ExportReader struct {
+	scanner   *bufio.Scanner
+	converter *Converter
+	line      int
+}
+
+// NewExportReader creates a reader for NEGRA export data that converts
+// the sentences using 'converter'. The caller is responsible for
+// closing the provided reader.
+func NewExportReader(r *bufio.Reader, converter *Converter) *ExportReader {
+	return &ExportReader{
+		scanner:   bufio.NewScanner(r),
+		converter: converter,
+	}
+}
+
+func (r *ExportReader) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("Line %d: %s", r.line, fmt.Sprintf(format, args...))
+}
+
+// ReadSentence returns the next sentence as a dependency tree. If there
+// is no more data that can be read, io.EOF is returned as the error.
+func (r *ExportReader) ReadSentence() (conllx.Sentence, error) {
+	if err := r.skipToSentence(); err != nil {
+		return nil, err
+	}
+
+	g := newGraph()
+	g.root = exportRoot
+	g.nonterminals[exportRoot] = &nonterminal{cat: "VROOT"}
+
+	for r.scanner.Scan() {
+		r.line++
+
+		line := r.scanner.Text()
+		if idx := strings.Index(line, "%%"); idx != -1 {
+			line = line[:idx]
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		if strings.HasPrefix(fields[0], "#EOS") {
+			return r.converter.convert(g)
+		}
+
+		if err := r.addNode(g, fields); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, r.errorf("Unexpected end of input in sentence")
+}
+
+// skipToSentence skips lines until the start of a sentence (#BOS).
+func (r *ExportReader) skipToSentence() error {
+	for r.scanner.Scan() {
+		r.line++
+
+		if strings.HasPrefix(r.scanner.Text(), "#BOS") {
+			return nil
+		}
+	}
+
+	if err := r.scanner.Err(); err != nil {
+		return err
+	}
+
+	return io.EOF
+}
+
+// addNode adds a terminal or nonterminal line to the graph. The format
+// version is determined from the number of fields: version 3 lines
+// have an odd number of fields, version 4 lines have an additional
+// lemma field.
+func (r *ExportReader) addNode(g *graph, fields []string) error {
+	hasLemma := len(fields)%2 == 0
+
+	minFields := 5
+	if hasLemma {
+		minFields = 6
+	}
+
+	if len(fields) < minFields {
+		return r.errorf("Expected at least %d fields, got %d", minFields, len(fields))
+	}
+
+	word, lemma, rest := fields[0], "", fields[1:]
+	if hasLemma {
+		lemma, rest = fields[1], fields[2:]
+	}
+
+	label, morph, edgeLabel, parent := rest[0], rest[1], rest[2], rest[3]
+	if _, err := strconv.Atoi(parent); err != nil {
+		return r.errorf("Invalid parent: %s", parent)
+	}
+
+	var id string
+	if isNonterminal(word) {
+		id = word
+		node := r.nonterminal(g, id)
+		node.cat = label
+	} else {
+		id = "t" + strconv.Itoa(len(g.terminals))
+		g.addTerminal(id, terminal{
+			word:  word,
+			lemma: lemma,
+			pos:   label,
+			morph: morph,
+		})
+	}
+
+	parentNode := r.nonterminal(g, "#"+parent)
+	parentNode.edges = append(parentNode.edges, edge{label: edgeLabel, child: id})
+
+	return nil
+}
+
+// nonterminal returns the nonterminal with the given identifier,
+// creating it when it was not seen before. Nonterminals can be used as
+// parents before their own lines are read.
+func (r *ExportReader) nonterminal(g *graph, id string) *nonterminal {
+	node, ok := g.nonterminals[id]
+	if !ok {
+		node = &nonterminal{}
+		g.nonterminals[id] = node
+	}
+
+	return node
+}
+
+// isNonterminal returns true if the first field of a line is a
+// nonterminal identifier, such as #500. Words consisting of a hash sign
+// are terminals.
+func isNonterminal(field string) bool {
+	if len(field) < 2 || field[0] != '#' {
+		return false
+	}
+
+	n, err := strconv.Atoi(field[1:])
+	return err == nil && n >= 500
+}
@@ -0,0 +1,184 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tiger
+
+import (
+	"bufio"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+const testXML = `<?xml version="1.0" encoding="UTF-8"?>
+<corpus id="test">
+  <body>
+    <s id="s1">
+      <graph root="s1_502">
+        <terminals>
+          <t id="s1_1" word="Die" lemma="die" pos="ART" morph="Nom.Sg.Fem"/>
+          <t id="s1_2" word="Katze" lemma="Katze" pos="NN" morph="Nom.Sg.Fem"/>
+          <t id="s1_3" word="hat" lemma="haben" pos="VAFIN" morph="3.Sg.Pres.Ind"/>
+          <t id="s1_4" word="Hunger" lemma="Hunger" pos="NN" morph="Acc.Sg.Masc"/>
+          <t id="s1_5" word="." lemma="--" pos="$." morph="--"/>
+        </terminals>
+        <nonterminals>
+          <nt id="s1_500" cat="NP">
+            <edge label="NK" idref="s1_1"/>
+            <edge label="NK" idref="s1_2"/>
+          </nt>
+          <nt id="s1_501" cat="S">
+            <edge label="HD" idref="s1_3"/>
+            <edge label="SB" idref="s1_500"/>
+            <edge label="OA" idref="s1_4"/>
+            <secedge label="SB" idref="s1_4"/>
+          </nt>
+          <nt id="s1_502" cat="VROOT">
+            <edge label="--" idref="s1_501"/>
+            <edge label="--" idref="s1_5"/>
+          </nt>
+        </nonterminals>
+      </graph>
+    </s>
+  </body>
+</corpus>`
+
+// Sentence with a discontinuous VP, in export format 4 (with lemmas).
+const testExport4 = `#FORMAT 4
+#BOT ORIGIN
+0	test
+#EOT ORIGIN
+#BOS 1 0 0 0
+Hunger	Hunger	NN	Acc.Sg.Masc	OA	500
+hat	haben	VAFIN	3.Sg.Pres.Ind	HD	501
+sie	sie	PPER	3.Nom.Sg.Fem	SB	501
+gehabt	haben	VVPP	--	HD	500
+.	--	$.	--	--	0
+#500	--	VP	--	OC	501
+#501	--	S	--	--	0
+#EOS 1
+`
+
+// The same sentence in export format 3, with a secondary edge.
+const testExport3 = `#BOS 1 0 0 0
+Hunger	NN	Acc.Sg.Masc	OA	500
+hat	VAFIN	3.Sg.Pres.Ind	HD	501	%% comment
+sie	PPER	3.Nom.Sg.Fem	SB	501	SB	500
+gehabt	VVPP	--	HD	500
+.	$.	--	--	0
+#500	VP	--	OC	501
+#501	S	--	--	0
+#EOS 1
+`
+
+type dependency struct {
+	form     string
+	features string
+	head     uint
+	rel      string
+}
+
+func dependencies(sentence conllx.Sentence) []dependency {
+	var deps []dependency
+	for idx := range sentence {
+		form, _ := sentence[idx].Form()
+		features, _ := sentence[idx].Layer(conllx.FeaturesLayer)
+		head, _ := sentence[idx].Head()
+		rel, _ := sentence[idx].HeadRel()
+		deps = append(deps, dependency{form, features, head, rel})
+	}
+
+	return deps
+}
+
+func readSingle(t *testing.T, reader conllx.SentenceReader) conllx.Sentence {
+	sentence, err := reader.ReadSentence()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if _, err := reader.ReadSentence(); err != io.EOF {
+		t.Fatal("Expected io.EOF, got:", err)
+	}
+
+	return sentence
+}
+
+func TestXMLReader(t *testing.T) {
+	sentence := readSingle(t, NewXMLReader(strings.NewReader(testXML), DefaultConverter()))
+
+	expected := []dependency{
+		{"Die", "nsf", 2, "NK"},
+		{"Katze", "nsf", 3, "SB"},
+		{"hat", "3spi", 0, "ROOT"},
+		{"Hunger", "asm", 3, "OA"},
+		{".", "", 3, "--"},
+	}
+
+	if deps := dependencies(sentence); !reflect.DeepEqual(deps, expected) {
+		t.Fatalf("Expected dependencies:\n%v\ngot:\n%v", expected, deps)
+	}
+
+	if lemma, ok := sentence[2].Lemma(); !ok || lemma != "haben" {
+		t.Fatal("Expected lemma haben, got:", lemma)
+	}
+
+	if _, ok := sentence[4].Lemma(); ok {
+		t.Fatal("Empty lemmas should be absent")
+	}
+}
+
+func TestExportReader(t *testing.T) {
+	expected := []dependency{
+		{"Hunger", "asm", 4, "OA"},
+		{"hat", "3spi", 0, "ROOT"},
+		{"sie", "3nsf", 2, "SB"},
+		{"gehabt", "", 2, "OC"},
+		{".", "", 2, "--"},
+	}
+
+	for _, data := range []string{testExport4, testExport3} {
+		reader := NewExportReader(bufio.NewReader(strings.NewReader(data)), DefaultConverter())
+		sentence := readSingle(t, reader)
+
+		if deps := dependencies(sentence); !reflect.DeepEqual(deps, expected) {
+			t.Fatalf("Expected dependencies:\n%v\ngot:\n%v", expected, deps)
+		}
+
+		if err := sentence.ValidateTree(); err != nil {
+			t.Fatal("Converted sentence is not a tree:", err)
+		}
+	}
+}
+
+func TestExportReaderErrors(t *testing.T) {
+	for _, data := range []string{
+		"#BOS 1\nHunger\tNN\tAcc.Sg.Masc\tOA\t500\n",
+		"#BOS 1\nHunger\tNN\tOA\t0\n#EOS 1\n",
+		"#BOS 1\nHunger\tNN\t--\tOA\tx\n#EOS 1\n",
+		"#BOS 1\nHunger\tNN\t--\tOA\t500\n#500\tNP\t--\tOA\t501\n#501\tNP\t--\tOA\t500\n#EOS 1\n",
+	} {
+		reader := NewExportReader(bufio.NewReader(strings.NewReader(data)), DefaultConverter())
+		if _, err := reader.ReadSentence(); err == nil || err == io.EOF {
+			t.Fatalf("Expected an error for %q, got: %v", data, err)
+		}
+	}
+}
+
+func TestCompactMorph(t *testing.T) {
+	for morph, expected := range map[string]string{
+		"Nom.Sg.Fem":    "nsf",
+		"3.Sg.Pres.Ind": "3spi",
+		"*.*.*":         "***",
+		"--":            "",
+		"":              "",
+	} {
+		if compact := compactMorph(morph); compact != expected {
+			t.Errorf("Expected %q for %q, got %q", expected, morph, compact)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tiger
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+type xmlTerminal struct {
+	ID    string `xml:"id,attr"`
+	Word  string `xml:"word,attr"`
+	Lemma string `xml:"lemma,attr"`
+	Pos   string `xml:"pos,attr"`
+	Morph string `xml:"morph,attr"`
+}
+
+type xmlEdge struct {
+	Label string `xml:"label,attr"`
+	IDRef string `xml:"idref,attr"`
+}
+
+type xmlNonterminal struct {
+	ID    string    `xml:"id,attr"`
+	Cat   string    `xml:"cat,attr"`
+	Edges []xmlEdge `xml:"edge"`
+}
+
+type xmlSentence struct {
+	ID    string `xml:"id,attr"`
+	Graph struct {
+		Root         string           `xml:"root,attr"`
+		Terminals    []xmlTerminal    `xml:"terminals>t"`
+		Nonterminals []xmlNonterminal `xml:"nonterminals>nt"`
+	} `xml:"graph"`
+}
+
+var _ conllx.SentenceReader = &XMLReader{}
+
+// An XMLReader reads sentences from a TIGER-XML corpus and converts
+// them to dependency trees. Secondary edges are ignored.
+type XMLReader struct {
+	decoder   *xml.Decoder
+	converter *Converter
+}
+
+// NewXMLReader creates a reader for TIGER-XML data that converts the
+// sentences using 'converter'. The caller is responsible for closing
+// the provided reader.
+func NewXMLReader(r io.Reader, converter *Converter) *XMLReader {
+	return &XMLReader{
+		decoder:   xml.NewDecoder(r),
+		converter: converter,
+	}
+}
+
+// ReadSentence returns the next sentence as a dependency tree. If there
+// is no more data that can be read, io.EOF is returned as the error.
+func (r *XMLReader) ReadSentence() (conllx.Sentence, error) {
+	for {
+		tok, err := r.decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		start, ok := tok.(xml.StartElement)
+		if !ok || start.Name.Local != "s" {
+			continue
+		}
+
+		var s xmlSentence
+		if err := r.decoder.DecodeElement(&s, &start); err != nil {
+			return nil, err
+		}
+
+		sentence, err := r.converter.convert(s.graph())
+		if err != nil {
+			return nil, fmt.Errorf("Sentence %s: %s", s.ID, err)
+		}
+
+		return sentence, nil
+	}
+}
+
+func (s *xmlSentence) graph() *graph {
+	g := newGraph()
+
+	for _, t := range s.Graph.Terminals {
+		g.addTerminal(t.ID, terminal{
+			word:  t.Word,
+			lemma: t.Lemma,
+			pos:   t.Pos,
+			morph: t.Morph,
+		})
+	}
+
+	for _, nt := range s.Graph.Nonterminals {
+		node := &nonterminal{cat: nt.Cat}
+		for _, e := range nt.Edges {
+			node.edges = append(node.edges, edge{label: e.Label, child: e.IDRef})
+		}
+		g.nonterminals[nt.ID] = node
+	}
+
+	g.root = s.Graph.Root
+
+	return g
+}
@@ -39,3 +39,79 @@ func TestSentenceStringer(t *testing.T) {
 		t.Fatalf("Stringer error. Expected:\n%s\nGot\n%s", stringerTestCheck, stringerTestToken.String())
 	}
 }
+
+func TestTokenClone(t *testing.T) {
+	token := NewToken().SetForm("Deleuze").SetFeatures(map[string]string{"case": "nominative"})
+	tokenCopy := token.Clone()
+
+	tokenCopy.SetForm("Gilles")
+	features, _ := tokenCopy.Features()
+	features.FeaturesMap()["case"] = "genitive"
+
+	if form, _ := token.Form(); form != "Deleuze" {
+		t.Fatalf("Modifying the form of a clone changed the original: %s", form)
+	}
+
+	features, _ = token.Features()
+	if features.FeaturesMap()["case"] != "nominative" {
+		t.Fatal("Modifying the features of a clone changed the original")
+	}
+}
+
+func TestSentenceClone(t *testing.T) {
+	r := stringReader(testFragment)
+	s1, err := r.ReadSentence()
+	equalOrFail(t, err, testFragmentSent1, s1)
+
+	s1Copy := s1.Clone()
+	equalOrFail(t, nil, testFragmentSent1, s1Copy)
+
+	if s1Copy[0].features == s1[0].features {
+		t.Fatal("Features of a cloned sentence should not be shared")
+	}
+
+	_, err = r.ReadSentence()
+	equalOrFail(t, err, testFragmentSent1, s1Copy)
+
+	if Sentence(nil).Clone() != nil {
+		t.Fatal("Clone of a nil sentence should be nil")
+	}
+}
+
+func TestExtraColumns(t *testing.T) {
+	token := NewToken().SetForm("Gilles").SetExtraColumn(1, "0.93")
+
+	if extra := token.ExtraColumns(); len(extra) != 2 || extra[0] != "_" || extra[1] != "0.93" {
+		t.Fatalf("Unexpected extra columns: %v", extra)
+	}
+
+	if _, ok := token.ExtraColumn(0); ok {
+		t.Fatal("Extra column with an underscore should be absent")
+	}
+
+	if value, ok := token.ExtraColumn(1); !ok || value != "0.93" {
+		t.Fatalf("Expected extra column 0.93, got: %s", value)
+	}
+
+	if _, ok := token.ExtraColumn(2); ok {
+		t.Fatal("Extra column out of bounds should be absent")
+	}
+
+	tokenCopy := token.Clone()
+	tokenCopy.SetExtraColumn(1, "0.5")
+	if value, _ := token.ExtraColumn(1); value != "0.93" {
+		t.Fatal("Modifying the extra columns of a clone changed the original")
+	}
+
+	if s := token.String(); s != "Gilles\t_\t_\t_\t_\t_\t_\t_\t_\t_\t0.93" {
+		t.Fatalf("Stringer error, got:\n%s", s)
+	}
+
+	if extra := token.SetExtraColumn(-1, "x").ExtraColumns(); len(extra) != 2 {
+		t.Fatalf("Negative extra column index should be ignored, got: %v", extra)
+	}
+
+	if token.SetExtraColumns().ExtraColumns() != nil {
+		t.Fatal("Extra columns should be removed")
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conllx
+
+import "fmt"
+
+// ValidateTree checks that the HEAD layer of the sentence forms a
+// dependency tree: every token has a head, every head is a token or the
+// artificial root (0), and there are no cycles. A descriptive error is
+// returned if the sentence is not a tree.
+func (s Sentence) ValidateTree() error {
+	heads := make([]uint, len(s))
+	for idx := range s {
+		head, ok := s[idx].Head()
+		if !ok {
+			return fmt.Errorf("Token %d does not have a head", idx+1)
+		}
+
+		if head > uint(len(s)) {
+			return fmt.Errorf("Head of token %d is out of bounds: %d", idx+1, head)
+		}
+
+		if head == uint(idx+1) {
+			return fmt.Errorf("Token %d is its own head", idx+1)
+		}
+
+		heads[idx] = head
+	}
+
+	// Follow the heads from every token, we should reach the root
+	// in fewer steps than there are tokens.
+	for idx := range heads {
+		head := uint(idx + 1)
+		for steps := 0; head != 0; steps++ {
+			if steps > len(heads) {
+				return fmt.Errorf("Token %d is part of a cycle", idx+1)
+			}
+
+			head = heads[head-1]
+		}
+	}
+
+	return nil
+}
+
+// IsProjective returns true if the sentence is a projective dependency
+// tree. A tree is projective when every token that is between a token
+// and its head is dominated by that head. Sentences that are not trees
+// (see ValidateTree) are not projective.
+func (s Sentence) IsProjective() bool {
+	if s.ValidateTree() != nil {
+		return false
+	}
+
+	return len(s.nonProjectiveArcs()) == 0
+}
+
+// NonProjectiveArcs returns the indices (starting at 1) of the tokens
+// that are attached to their heads with a non-projective arc. An error
+// is returned if the sentence is not a tree.
+func (s Sentence) NonProjectiveArcs() ([]uint, error) {
+	if err := s.ValidateTree(); err != nil {
+		return nil, err
+	}
+
+	return s.nonProjectiveArcs(), nil
+}
+
+// nonProjectiveArcs assumes that the sentence is a valid tree.
+func (s Sentence) nonProjectiveArcs() []uint {
+	var arcs []uint
+
+	for idx := range s {
+		dependent := uint(idx + 1)
+		head, _ := s[idx].Head()
+
+		start, end := head, dependent
+		if start > end {
+			start, end = end, start
+		}
+
+		for between := start + 1; between < end; between++ {
+			if !s.dominates(head, between) {
+				arcs = append(arcs, dependent)
+				break
+			}
+		}
+	}
+
+	return arcs
+}
+
+// dominates assumes that the sentence is a valid tree.
+func (s Sentence) dominates(ancestor, token uint) bool {
+	for token != 0 {
+		if token == ancestor {
+			return true
+		}
+
+		token, _ = s[token-1].Head()
+	}
+
+	return ancestor == 0
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conllx
+
+import (
+	"reflect"
+	"testing"
+)
+
+func headSentence(heads ...uint) Sentence {
+	sentence := make(Sentence, len(heads))
+	for idx, head := range heads {
+		sentence[idx] = *NewToken().SetHead(head)
+	}
+
+	return sentence
+}
+
+func TestValidateTree(t *testing.T) {
+	if err := Sentence(testFragmentSent1).ValidateTree(); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	invalid := []Sentence{
+		{*NewToken().SetForm("a")},
+		headSentence(0, 3),
+		headSentence(0, 2),
+		headSentence(3, 1, 2),
+	}
+
+	for _, sentence := range invalid {
+		if err := sentence.ValidateTree(); err == nil {
+			t.Fatalf("Sentence should not be a valid tree: %v", sentence)
+		}
+	}
+}
+
+func TestIsProjective(t *testing.T) {
+	if !headSentence(2, 0, 2).IsProjective() {
+		t.Fatal("Sentence should be projective")
+	}
+
+	// Token 3 is between token 2 and its head 4, but is not dominated by 4.
+	nonProjective := headSentence(0, 4, 1, 1)
+	if nonProjective.IsProjective() {
+		t.Fatal("Sentence should not be projective")
+	}
+
+	arcs, err := nonProjective.NonProjectiveArcs()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if !reflect.DeepEqual(arcs, []uint{2}) {
+		t.Fatal("Unexpected non-projective arcs:", arcs)
+	}
+
+	if headSentence(2, 1).IsProjective() {
+		t.Fatal("A sentence with a cycle should not be projective")
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vocab
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+const fileHeader = "conllx-vocab 1"
+
+// Write writes the vocabularies in a line-based text format. The file
+// starts with a header line, followed by a section per layer. Each
+// section starts with the line 'layer<TAB>NAME<TAB>SIZE', followed by
+// SIZE lines with a quoted symbol and its count, separated by a tab.
+// The symbols are written in the order of their identifiers, the
+// reserved symbols are not written.
+func (v *Vocabularies) Write(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintln(bw, fileHeader)
+
+	for _, layer := range v.layers {
+		vocab := v.vocabs[layer]
+		fmt.Fprintf(bw, "layer\t%s\t%d\n", layer, vocab.Len()-2)
+
+		for id := UnknownID + 1; id < vocab.Len(); id++ {
+			fmt.Fprintf(bw, "%s\t%d\n", strconv.Quote(vocab.symbols[id]), vocab.counts[id])
+		}
+	}
+
+	return bw.Flush()
+}
+
+// Read reads vocabularies that were written using Vocabularies.Write.
+func Read(r io.Reader) (*Vocabularies, error) {
+	scanner := bufio.NewScanner(r)
+
+	if !scanner.Scan() || scanner.Text() != fileHeader {
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("Not a vocabulary file, expected header: %s", fileHeader)
+	}
+
+	vocabs := &Vocabularies{
+		vocabs: make(map[conllx.Layer]*Vocab),
+	}
+
+	for scanner.Scan() {
+		parts := strings.Split(scanner.Text(), "\t")
+		if len(parts) != 3 || parts[0] != "layer" {
+			return nil, fmt.Errorf("Expected layer header, got: %s", scanner.Text())
+		}
+
+		layer, err := conllx.ParseLayer(parts[1])
+		if err != nil {
+			return nil, err
+		}
+
+		size, err := strconv.Atoi(parts[2])
+		if err != nil {
+			return nil, err
+		}
+
+		vocab := newVocab()
+		for i := 0; i < size; i++ {
+			if !scanner.Scan() {
+				return nil, fmt.Errorf("Layer %s is truncated", layer)
+			}
+
+			symbol, count, err := parseSymbol(scanner.Text())
+			if err != nil {
+				return nil, err
+			}
+
+			vocab.add(symbol, count)
+		}
+
+		vocabs.layers = append(vocabs.layers, layer)
+		vocabs.vocabs[layer] = vocab
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return vocabs, nil
+}
+
+func parseSymbol(line string) (string, int, error) {
+	sepIdx := strings.LastIndexByte(line, '\t')
+	if sepIdx == -1 {
+		return "", 0, fmt.Errorf("Expected symbol and count, got: %s", line)
+	}
+
+	symbol, err := strconv.Unquote(line[:sepIdx])
+	if err != nil {
+		return "", 0, fmt.Errorf("Cannot unquote symbol %s: %s", line[:sepIdx], err)
+	}
+
+	count, err := strconv.Atoi(line[sepIdx+1:])
+	if err != nil {
+		return "", 0, err
+	}
+
+	return symbol, count, nil
+}
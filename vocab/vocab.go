@@ -0,0 +1,286 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package vocab builds integer indices for the annotation layers of
+// CoNLL-X corpora, for instance to feed sentences to neural networks.
+package vocab
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+const (
+	// PaddingID is the identifier that is reserved for padding. It is
+	// also used to encode absent layer values.
+	PaddingID = 0
+
+	// UnknownID is the identifier of values that are not in the
+	// vocabulary.
+	UnknownID = 1
+)
+
+const (
+	// PaddingSymbol is the symbol of PaddingID.
+	PaddingSymbol = "<pad>"
+
+	// UnknownSymbol is the symbol of UnknownID.
+	UnknownSymbol = "<unk>"
+)
+
+// A Vocab maps the symbols of one annotation layer to integer
+// identifiers and vice versa. Identifiers are assigned in the order of
+// descending frequency, ties are broken by the lexicographic order of
+// the symbols. As a result, the identifiers are stable for a corpus.
+type Vocab struct {
+	symbols []string
+	counts  []int
+	ids     map[string]int
+}
+
+func newVocab() *Vocab {
+	v := &Vocab{
+		ids: make(map[string]int),
+	}
+
+	v.add(PaddingSymbol, 0)
+	v.add(UnknownSymbol, 0)
+
+	return v
+}
+
+func (v *Vocab) add(symbol string, count int) {
+	v.ids[symbol] = len(v.symbols)
+	v.symbols = append(v.symbols, symbol)
+	v.counts = append(v.counts, count)
+}
+
+// ID returns the identifier of a symbol, UnknownID is returned for
+// symbols that are not in the vocabulary.
+func (v *Vocab) ID(symbol string) int {
+	if id, ok := v.ids[symbol]; ok {
+		return id
+	}
+
+	return UnknownID
+}
+
+// Symbol returns the symbol of an identifier. UnknownSymbol is returned
+// for identifiers that are out of range.
+func (v *Vocab) Symbol(id int) string {
+	if id < 0 || id >= len(v.symbols) {
+		return UnknownSymbol
+	}
+
+	return v.symbols[id]
+}
+
+// Count returns the corpus frequency of the symbol with the given
+// identifier. The count of the reserved symbols is zero.
+func (v *Vocab) Count(id int) int {
+	if id < 0 || id >= len(v.counts) {
+		return 0
+	}
+
+	return v.counts[id]
+}
+
+// Len returns the size of the vocabulary, including the reserved
+// padding and unknown symbols.
+func (v *Vocab) Len() int {
+	return len(v.symbols)
+}
+
+// A Builder counts the values of annotation layers to build
+// vocabularies.
+type Builder struct {
+	layers []conllx.Layer
+	counts map[conllx.Layer]map[string]int
+}
+
+// NewBuilder creates a builder for the vocabularies of the given
+// layers.
+func NewBuilder(layers ...conllx.Layer) *Builder {
+	counts := make(map[conllx.Layer]map[string]int)
+	for _, layer := range layers {
+		counts[layer] = make(map[string]int)
+	}
+
+	return &Builder{
+		layers: layers,
+		counts: counts,
+	}
+}
+
+// Add counts the layer values of a sentence.
+func (b *Builder) Add(sentence conllx.Sentence) {
+	for idx := range sentence {
+		for _, layer := range b.layers {
+			if value, ok := sentence[idx].Layer(layer); ok {
+				b.counts[layer][value]++
+			}
+		}
+	}
+}
+
+// Build returns the vocabularies. Symbols that occur fewer times than
+// the cutoff of their layer are not added to the vocabulary of that
+// layer. Layers without a cutoff in 'cutoffs' include all symbols.
+func (b *Builder) Build(cutoffs map[conllx.Layer]int) *Vocabularies {
+	vocabs := make(map[conllx.Layer]*Vocab)
+
+	for _, layer := range b.layers {
+		cutoff := cutoffs[layer]
+
+		var symbols []string
+		for symbol, count := range b.counts[layer] {
+			if count >= cutoff {
+				symbols = append(symbols, symbol)
+			}
+		}
+
+		counts := b.counts[layer]
+		sort.Slice(symbols, func(i, j int) bool {
+			if counts[symbols[i]] != counts[symbols[j]] {
+				return counts[symbols[i]] > counts[symbols[j]]
+			}
+
+			return symbols[i] < symbols[j]
+		})
+
+		v := newVocab()
+		for _, symbol := range symbols {
+			// Do not shadow the reserved symbols.
+			if _, ok := v.ids[symbol]; !ok {
+				v.add(symbol, counts[symbol])
+			}
+		}
+
+		vocabs[layer] = v
+	}
+
+	return &Vocabularies{
+		layers: b.layers,
+		vocabs: vocabs,
+	}
+}
+
+// Build reads all sentences from a reader and builds vocabularies for
+// the given layers, using the same cutoff for every layer.
+func Build(reader conllx.SentenceReader, cutoff int, layers ...conllx.Layer) (*Vocabularies, error) {
+	builder := NewBuilder(layers...)
+
+	for {
+		sentence, err := reader.ReadSentence()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		builder.Add(sentence)
+	}
+
+	cutoffs := make(map[conllx.Layer]int)
+	for _, layer := range layers {
+		cutoffs[layer] = cutoff
+	}
+
+	return builder.Build(cutoffs), nil
+}
+
+// Vocabularies holds the vocabularies of a set of annotation layers.
+type Vocabularies struct {
+	layers []conllx.Layer
+	vocabs map[conllx.Layer]*Vocab
+}
+
+// Layers returns the layers for which there are vocabularies.
+func (v *Vocabularies) Layers() []conllx.Layer {
+	return v.layers
+}
+
+// Vocab returns the vocabulary of a layer, the second tuple element is
+// false when there is no vocabulary for the layer.
+func (v *Vocabularies) Vocab(layer conllx.Layer) (*Vocab, bool) {
+	vocab, ok := v.vocabs[layer]
+	return vocab, ok
+}
+
+// Encoded is the integer representation of a sentence.
+type Encoded struct {
+	// Layers contains the identifiers of the tokens for each layer
+	// that has a vocabulary. Absent values are encoded as PaddingID.
+	Layers map[conllx.Layer][]int
+
+	// Heads contains the head of each token, -1 for absent heads.
+	Heads []int
+}
+
+// Encode converts a sentence to its integer representation.
+func (v *Vocabularies) Encode(sentence conllx.Sentence) *Encoded {
+	encoded := &Encoded{
+		Layers: make(map[conllx.Layer][]int),
+		Heads:  make([]int, len(sentence)),
+	}
+
+	for _, layer := range v.layers {
+		vocab := v.vocabs[layer]
+		ids := make([]int, len(sentence))
+		for idx := range sentence {
+			if value, ok := sentence[idx].Layer(layer); ok {
+				ids[idx] = vocab.ID(value)
+			}
+		}
+
+		encoded.Layers[layer] = ids
+	}
+
+	for idx := range sentence {
+		if head, ok := sentence[idx].Head(); ok {
+			encoded.Heads[idx] = int(head)
+		} else {
+			encoded.Heads[idx] = -1
+		}
+	}
+
+	return encoded
+}
+
+// Decode converts an integer representation to a sentence. Only the
+// layers that have a vocabulary and the heads are restored. Layer
+// values that were not in the vocabulary become UnknownSymbol. An
+// error is returned when the identifiers of a layer and the heads have
+// different lengths.
+func (v *Vocabularies) Decode(encoded *Encoded) (conllx.Sentence, error) {
+	for _, layer := range v.layers {
+		if ids, ok := encoded.Layers[layer]; ok && len(ids) != len(encoded.Heads) {
+			return nil, fmt.Errorf("Layer %s has %d identifiers, but there are %d heads",
+				layer, len(ids), len(encoded.Heads))
+		}
+	}
+
+	sentence := make(conllx.Sentence, len(encoded.Heads))
+
+	for _, layer := range v.layers {
+		vocab := v.vocabs[layer]
+		for idx, id := range encoded.Layers[layer] {
+			if id != PaddingID {
+				sentence[idx].SetLayer(layer, vocab.Symbol(id))
+			}
+		}
+	}
+
+	for idx, head := range encoded.Heads {
+		if head >= 0 {
+			sentence[idx].SetHead(uint(head))
+		}
+	}
+
+	return sentence, nil
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 The conllx Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vocab
+
+import (
+	"bufio"
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/danieldk/conllx.v1"
+)
+
+const testFragment string = `1	Die	die	ART	ART	nsf	2	DET
+2	Großaufnahme	Großaufnahme	N	NN	nsf	0	ROOT
+
+1	Gilles	Gilles	N	NE	nsm	0	ROOT
+2	Deleuze	Deleuze	N	NE	case:nominative|number:singular|gender:masculine	1	APP
+
+1	die	_	ART	ART	_	0	ROOT`
+
+var testLayers = []conllx.Layer{
+	conllx.FormLayer,
+	conllx.PosTagLayer,
+	conllx.FeaturesLayer,
+	conllx.HeadRelLayer,
+}
+
+func buildTestVocab(t *testing.T, cutoff int) *Vocabularies {
+	reader := conllx.NewReader(bufio.NewReader(strings.NewReader(testFragment)))
+	v, err := Build(reader, cutoff, testLayers...)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	return v
+}
+
+func TestBuild(t *testing.T) {
+	v := buildTestVocab(t, 2)
+
+	tags, ok := v.Vocab(conllx.PosTagLayer)
+	if !ok {
+		t.Fatal("Expected a vocabulary for the POS layer")
+	}
+
+	// ART and NE occur twice, NN once. Ties are broken lexicographically.
+	if tags.Len() != 4 || tags.ID("ART") != 2 || tags.ID("NE") != 3 {
+		t.Fatalf("Unexpected POS vocabulary: %v", tags.symbols)
+	}
+
+	if tags.ID("NN") != UnknownID {
+		t.Fatal("Tags below the cutoff should be unknown")
+	}
+
+	if tags.Count(tags.ID("ART")) != 2 {
+		t.Fatal("Incorrect count for ART")
+	}
+
+	if _, ok := v.Vocab(conllx.LemmaLayer); ok {
+		t.Fatal("There should be no vocabulary for the lemma layer")
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	v := buildTestVocab(t, 1)
+
+	sentence := conllx.Sentence{
+		*conllx.NewToken().SetForm("Gilles").SetPosTag("NE").SetHead(0).SetHeadRel("ROOT"),
+		*conllx.NewToken().SetForm("Foucault").SetPosTag("NE").SetHead(1),
+	}
+
+	encoded := v.Encode(sentence)
+
+	forms, _ := v.Vocab(conllx.FormLayer)
+	if !reflect.DeepEqual(encoded.Layers[conllx.FormLayer], []int{forms.ID("Gilles"), UnknownID}) {
+		t.Fatal("Unexpected form identifiers:", encoded.Layers[conllx.FormLayer])
+	}
+
+	if !reflect.DeepEqual(encoded.Layers[conllx.FeaturesLayer], []int{PaddingID, PaddingID}) {
+		t.Fatal("Absent features should be encoded as padding:", encoded.Layers[conllx.FeaturesLayer])
+	}
+
+	if !reflect.DeepEqual(encoded.Heads, []int{0, 1}) {
+		t.Fatal("Unexpected heads:", encoded.Heads)
+	}
+
+	decoded, err := v.Decode(encoded)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	expected := "1\tGilles\t_\t_\tNE\t_\t0\tROOT\t_\t_\n2\t<unk>\t_\t_\tNE\t_\t1\t_\t_\t_"
+	if decoded.String() != expected {
+		t.Fatalf("Expected:\n%s\nGot:\n%s", expected, decoded)
+	}
+}
+
+func TestDecodeMismatchedLengths(t *testing.T) {
+	v := buildTestVocab(t, 1)
+
+	for _, encoded := range []*Encoded{
+		{Layers: map[conllx.Layer][]int{conllx.FormLayer: {2, 3}}},
+		{Layers: map[conllx.Layer][]int{conllx.FormLayer: {2}}, Heads: []int{0, 1}},
+	} {
+		if _, err := v.Decode(encoded); err == nil {
+			t.Fatal("Expected an error for mismatched lengths:", encoded)
+		}
+	}
+}
+
+func TestWriteRead(t *testing.T) {
+	v := buildTestVocab(t, 1)
+
+	var buf bytes.Buffer
+	if err := v.Write(&buf); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	read, err := Read(&buf)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if !reflect.DeepEqual(v, read) {
+		t.Fatalf("Vocabularies differ after serialization:\n%v\n%v", v, read)
+	}
+
+	if _, err := Read(strings.NewReader("vocab")); err == nil {
+		t.Fatal("expected error for a file without header")
+	}
+}
@@ -33,7 +33,9 @@ func NewWriter(w io.Writer) *Writer {
 }
 
 // WriteSentence writes a sentences in CoNLL-X format. For annotation layers
-// that are absent in a token underscores (_) are written.
+// that are absent in a token underscores (_) are written. Tokens with fewer
+// extra columns than other tokens of the sentence are padded with
+// underscores, so that all rows have the same number of columns.
 func (w *Writer) WriteSentence(sentence Sentence) error {
 	// Sentences are split using an extra newline. Moreover, there shouldn't
 	// be a newline after the last token of the stream. So, we always print
@@ -48,18 +50,25 @@ func (w *Writer) WriteSentence(sentence Sentence) error {
 
 	sentenceLen := len(sentence)
 
+	nExtra := 0
+	for idx := range sentence {
+		if n := len(sentence[idx].extra); n > nExtra {
+			nExtra = n
+		}
+	}
+
 	for idx, token := range sentence {
 		if idx == sentenceLen-1 {
-			fmt.Fprintf(w.writer, "%d\t%s", idx+1, w.formatToken(&token))
+			fmt.Fprintf(w.writer, "%d\t%s", idx+1, w.formatToken(&token, nExtra))
 		} else {
-			fmt.Fprintf(w.writer, "%d\t%s\n", idx+1, w.formatToken(&token))
+			fmt.Fprintf(w.writer, "%d\t%s\n", idx+1, w.formatToken(&token, nExtra))
 		}
 	}
 
 	return nil
 }
 
-func (w Writer) formatToken(token *Token) string {
+func (w Writer) formatToken(token *Token, nExtra int) string {
 	cols := []string{
 		w.formatColumn(token.Form),
 		w.formatColumn(token.Lemma),
@@ -72,6 +81,11 @@ func (w Writer) formatToken(token *Token) string {
 		w.formatColumn(token.PHeadRel),
 	}
 
+	cols = append(cols, token.ExtraColumns()...)
+	for len(cols) < 9+nExtra {
+		cols = append(cols, "_")
+	}
+
 	return strings.Join(cols, "\t")
 }
 
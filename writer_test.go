@@ -7,6 +7,7 @@ package conllx
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"testing"
 )
 
@@ -59,3 +60,47 @@ func ExampleWriter() {
 	// 1	Go	_	_	name	_	_	_	_	_
 	// 2	rocks	_	_	verb	_	_	_	_	_
 }
+
+const testFragmentExtra string = `1	Die	die	ART	ART	nsf	2	DET	_	_	0.97	B-NP
+2	Großaufnahme	Großaufnahme	N	NN	nsf	0	ROOT	_	_	0.99	I-NP
+
+1	Gilles	Gilles	N	NE	nsm	0	ROOT	_	_	_	B-PER`
+
+func TestWriterExtraColumns(t *testing.T) {
+	r := stringReader(testFragmentExtra)
+
+	var buffer bytes.Buffer
+	writer := NewWriter(&buffer)
+
+	for {
+		sentence, err := r.ReadSentence()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Sentence read should succeed: %s", err)
+		}
+
+		writer.WriteSentence(sentence)
+	}
+
+	if buffer.String() != testFragmentExtra {
+		t.Fatalf("Got:\n%s\nExpected:\n%s\n", buffer.String(), testFragmentExtra)
+	}
+}
+
+func TestWriterPadsExtraColumns(t *testing.T) {
+	sentence := Sentence{
+		*NewToken().SetForm("Gilles"),
+		*NewToken().SetForm("Deleuze").SetExtraColumns("B-PER", "0.93"),
+	}
+
+	var buffer bytes.Buffer
+	NewWriter(&buffer).WriteSentence(sentence)
+
+	expected := "1\tGilles\t_\t_\t_\t_\t_\t_\t_\t_\t_\t_\n" +
+		"2\tDeleuze\t_\t_\t_\t_\t_\t_\t_\t_\tB-PER\t0.93"
+	if buffer.String() != expected {
+		t.Fatalf("Got:\n%s\nExpected:\n%s\n", buffer.String(), expected)
+	}
+}